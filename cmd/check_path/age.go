@@ -13,6 +13,7 @@ import (
 
 	"github.com/atc0005/check-path/internal/config"
 	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
 	"github.com/atc0005/go-nagios"
 	"github.com/rs/zerolog"
 )
@@ -21,33 +22,34 @@ import (
 // values for age evaluation. If the specified age threshold values are
 // crossed, the provided *nagios.Plugin is updated and an error is returned
 // to signal that this specific check has found old files.
-func checkAge(path string, ths config.FileAgeThresholds, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+func checkAge(path string, ths config.FileAgeThresholds, tsSource string, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
 
 	// type conversion to expose desired methods
 	metaRecords := paths.MetaRecords(mrs)
 
+	records, tsErr := ageFiles(path, tsSource, zlog, nes, metaRecords)
+	if tsErr != nil {
+		return tsErr
+	}
+
 	// sort if more than one entry
-	if len(mrs) > 1 {
-		metaRecords.SortByModTimeAsc()
+	if len(records) > 1 {
+		records.SortByTimestampAsc()
 	}
 
-	for _, record := range metaRecords {
-
-		// skip age check for directories
-		if record.IsDir() {
-			continue
-		}
+	for _, record := range records {
 
 		criticalAgeFile := paths.AgeExceeded(
-			record.FileInfo, ths.Critical)
+			record.Timestamp, ths.Critical)
 
 		warningAgeFile := paths.AgeExceeded(
-			record.FileInfo, ths.Warning)
+			record.Timestamp, ths.Warning)
 
 		if criticalAgeFile || warningAgeFile {
 			zlog.Error().Err(paths.ErrPathOldFilesFound).
-				Int("critical_age_days", ths.Critical).
-				Int("warning_age_days", ths.Warning).
+				Dur("critical_age", ths.Critical).
+				Dur("warning_age", ths.Warning).
+				Str("age_timestamp", tsSource).
 				Bool("age_check_enabled", ths.Set).
 				Str("path", path).
 				Msg("old files found")
@@ -58,15 +60,20 @@ func checkAge(path string, ths config.FileAgeThresholds, zlog *zerolog.Logger, n
 				paths.ErrPathOldFilesFound,
 			))
 
-			fileAge := time.Since(record.ModTime()).Hours() / 24
+			fileAge := units.FormatDuration(time.Since(record.Timestamp))
 
 			nes.LongServiceOutput += fmt.Sprintf(
-				"* File %s** parent dir: %q%s** name: %q%s** age: %v%s",
+				"* File %s** parent dir: %q%s** name: %q%s** depth: %d%s** %s: %v%s** age: %s%s",
 				nagios.CheckOutputEOL,
 				record.ParentDir,
 				nagios.CheckOutputEOL,
 				record.Name(),
 				nagios.CheckOutputEOL,
+				record.Depth,
+				nagios.CheckOutputEOL,
+				tsSource,
+				record.Timestamp.Format(time.RFC3339),
+				nagios.CheckOutputEOL,
 				fileAge,
 				nagios.CheckOutputEOL,
 			)
@@ -74,9 +81,9 @@ func checkAge(path string, ths config.FileAgeThresholds, zlog *zerolog.Logger, n
 			switch {
 			case criticalAgeFile:
 				nes.ServiceOutput = fmt.Sprintf(
-					"%s: file older than %d days (%.2f) found [path: %q]",
+					"%s: file older than %s (%s) found [path: %q]",
 					nagios.StateCRITICALLabel,
-					ths.Critical,
+					units.FormatDuration(ths.Critical),
 					fileAge,
 					path,
 				)
@@ -87,9 +94,9 @@ func checkAge(path string, ths config.FileAgeThresholds, zlog *zerolog.Logger, n
 
 			case warningAgeFile:
 				nes.ServiceOutput = fmt.Sprintf(
-					"%s: file older than %d days (%.2f) found [path: %q]",
+					"%s: file older than %s (%s) found [path: %q]",
 					nagios.StateWARNINGLabel,
-					ths.Warning,
+					units.FormatDuration(ths.Warning),
 					fileAge,
 					path,
 				)
@@ -105,3 +112,141 @@ func checkAge(path string, ths config.FileAgeThresholds, zlog *zerolog.Logger, n
 	return nil
 
 }
+
+// checkAgeMin is a helper variadic function that accepts one or many
+// MetaRecord values for minimum age evaluation. If the specified minimum age
+// threshold values are not met, the provided *nagios.Plugin is updated and
+// an error is returned to signal that this specific check has found files
+// which are too new.
+func checkAgeMin(path string, ths config.FileAgeThresholds, tsSource string, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	// type conversion to expose desired methods
+	metaRecords := paths.MetaRecords(mrs)
+
+	records, tsErr := ageFiles(path, tsSource, zlog, nes, metaRecords)
+	if tsErr != nil {
+		return tsErr
+	}
+
+	// sort if more than one entry; newest files are evaluated first
+	if len(records) > 1 {
+		records.SortByTimestampDesc()
+	}
+
+	for _, record := range records {
+
+		criticalAgeFile := paths.AgeBelow(
+			record.Timestamp, ths.Critical)
+
+		warningAgeFile := paths.AgeBelow(
+			record.Timestamp, ths.Warning)
+
+		if criticalAgeFile || warningAgeFile {
+			zlog.Error().Err(paths.ErrPathNewFilesFound).
+				Dur("critical_age_min", ths.Critical).
+				Dur("warning_age_min", ths.Warning).
+				Str("age_timestamp", tsSource).
+				Bool("age_min_check_enabled", ths.Set).
+				Str("path", path).
+				Msg("new files found")
+
+			nes.AddError(fmt.Errorf(
+				"%d files & directories evaluated: %w",
+				len(metaRecords),
+				paths.ErrPathNewFilesFound,
+			))
+
+			fileAge := units.FormatDuration(time.Since(record.Timestamp))
+
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* File %s** parent dir: %q%s** name: %q%s** depth: %d%s** %s: %v%s** age: %s%s",
+				nagios.CheckOutputEOL,
+				record.ParentDir,
+				nagios.CheckOutputEOL,
+				record.Name(),
+				nagios.CheckOutputEOL,
+				record.Depth,
+				nagios.CheckOutputEOL,
+				tsSource,
+				record.Timestamp.Format(time.RFC3339),
+				nagios.CheckOutputEOL,
+				fileAge,
+				nagios.CheckOutputEOL,
+			)
+
+			switch {
+			case criticalAgeFile:
+				nes.ServiceOutput = fmt.Sprintf(
+					"%s: file newer than %s (%s) found [path: %q]",
+					nagios.StateCRITICALLabel,
+					units.FormatDuration(ths.Critical),
+					fileAge,
+					path,
+				)
+
+				nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+				return paths.ErrPathNewFilesFound
+
+			case warningAgeFile:
+				nes.ServiceOutput = fmt.Sprintf(
+					"%s: file newer than %s (%s) found [path: %q]",
+					nagios.StateWARNINGLabel,
+					units.FormatDuration(ths.Warning),
+					fileAge,
+					path,
+				)
+
+				nes.ExitStatusCode = nagios.StateWARNINGExitCode
+
+				return paths.ErrPathNewFilesFound
+			}
+
+		}
+	}
+
+	return nil
+
+}
+
+// ageFiles is a helper function used by age checks to pair files (skipping
+// directories) with the user-specified timestamp source. If the timestamp
+// source is not available for a file, the provided *nagios.Plugin is updated
+// to reflect an UNKNOWN state and an error is returned.
+func ageFiles(path string, tsSource string, zlog *zerolog.Logger, nes *nagios.Plugin, mrs paths.MetaRecords) (paths.TimestampedRecords, error) {
+
+	files := make(paths.MetaRecords, 0, len(mrs))
+	for _, record := range mrs {
+
+		// skip age check for directories
+		if record.IsDir() {
+			continue
+		}
+
+		files = append(files, record)
+	}
+
+	records, err := files.WithTimestamps(tsSource)
+	if err != nil {
+		zlog.Error().Err(err).
+			Str("age_timestamp", tsSource).
+			Str("path", path).
+			Msg("failed to retrieve timestamp for age evaluation")
+
+		nes.AddError(err)
+
+		nes.ServiceOutput = fmt.Sprintf(
+			"%s: unable to use %s for age evaluation [path: %q]",
+			nagios.StateUNKNOWNLabel,
+			tsSource,
+			path,
+		)
+
+		nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+		return nil, err
+	}
+
+	return records, nil
+
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkSecurityAudit is a helper variadic function that accepts one or many
+// MetaRecord values for evaluation of common security audit findings:
+// unexpected setuid/setgid executables and world-writable directories
+// missing the sticky bit. If any findings are present, the provided
+// *nagios.Plugin is updated and an error is returned to signal that this
+// specific check has found problems.
+func checkSecurityAudit(path string, audit config.SecurityAudit, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var setIDFound []paths.MetaRecord
+	var worldWritableDirsFound []paths.MetaRecord
+
+	for _, record := range mrs {
+		switch {
+		case audit.SetIDCheck && record.IsSetIDExecutable():
+			if textutils.InList(record.FQPath, audit.SetIDAllowed) {
+				zlog.Debug().
+					Str("path", record.FQPath).
+					Msg("skipping expected setuid/setgid executable")
+
+				continue
+			}
+			setIDFound = append(setIDFound, record)
+
+		case audit.WorldWritableDirsCheck && record.IsWorldWritableDirWithoutSticky():
+			worldWritableDirsFound = append(worldWritableDirsFound, record)
+		}
+	}
+
+	if len(setIDFound) == 0 && len(worldWritableDirsFound) == 0 {
+		return nil
+	}
+
+	var stateLabel string
+	var exitCode int
+	var auditErr error
+	problems := make([]string, 0, 2)
+
+	// Record findings for the less severe exit state first so that findings
+	// for the more severe exit state take precedence.
+	findings := []struct {
+		records  []paths.MetaRecord
+		critical bool
+		err      error
+		problem  string
+		item     string
+	}{
+		{
+			records:  worldWritableDirsFound,
+			critical: audit.WorldWritableDirsCritical,
+			err:      paths.ErrPathWorldWritableDir,
+			problem:  "world-writable directories without sticky bit",
+			item:     "world-writable directory without sticky bit",
+		},
+		{
+			records:  setIDFound,
+			critical: audit.SetIDCritical,
+			err:      paths.ErrPathSetIDExecutable,
+			problem:  "unexpected setuid/setgid executables",
+			item:     "unexpected setuid/setgid executable",
+		},
+	}
+
+	for _, finding := range findings {
+		if len(finding.records) == 0 {
+			continue
+		}
+
+		findingErr := fmt.Errorf(
+			"%d of %d items evaluated: %w",
+			len(finding.records),
+			len(mrs),
+			finding.err,
+		)
+
+		zlog.Error().Err(findingErr).
+			Bool("suid_sgid_check_enabled", audit.SetIDCheck).
+			Bool("world_writable_dirs_check_enabled", audit.WorldWritableDirsCheck).
+			Str("path", path).
+			Msg(finding.problem + " found")
+
+		nes.AddError(findingErr)
+
+		problems = append(
+			problems,
+			fmt.Sprintf("%d %s", len(finding.records), finding.problem),
+		)
+
+		for i, record := range finding.records {
+			if i >= maxListedPaths {
+				nes.LongServiceOutput += fmt.Sprintf(
+					"* Audit: %d additional items omitted%s",
+					len(finding.records)-maxListedPaths,
+					nagios.CheckOutputEOL,
+				)
+
+				break
+			}
+
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Audit %s** path: %q%s** mode: %v%s** problem: %s%s",
+				nagios.CheckOutputEOL,
+				record.FQPath,
+				nagios.CheckOutputEOL,
+				record.Mode(),
+				nagios.CheckOutputEOL,
+				finding.item,
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		switch {
+		case finding.critical:
+			stateLabel = nagios.StateCRITICALLabel
+			exitCode = nagios.StateCRITICALExitCode
+			auditErr = finding.err
+
+		case exitCode != nagios.StateCRITICALExitCode:
+			stateLabel = nagios.StateWARNINGLabel
+			exitCode = nagios.StateWARNINGExitCode
+			auditErr = finding.err
+		}
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %s found [path: %q]",
+		stateLabel,
+		strings.Join(problems, ", "),
+		path,
+	)
+
+	nes.ExitStatusCode = exitCode
+
+	return auditErr
+
+}
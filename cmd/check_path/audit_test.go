@@ -0,0 +1,125 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+func TestCheckSecurityAudit(t *testing.T) {
+	t.Parallel()
+
+	audit := config.SecurityAudit{
+		SetIDCheck:             true,
+		SetIDCritical:          true,
+		SetIDAllowed:           []string{"/usr/bin/passwd", "/usr/bin/sudo"},
+		WorldWritableDirsCheck: true,
+	}
+
+	tests := []struct {
+		name     string
+		audit    config.SecurityAudit
+		records  []paths.MetaRecord
+		wantCode int
+		wantErr  error
+	}{
+		{
+			name:  "no findings",
+			audit: audit,
+			records: []paths.MetaRecord{
+				testRecord("/usr/bin/ls", 0o755),
+				testRecord("/tmp", os.ModeDir|os.ModeSticky|0o777),
+			},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:  "allowed setuid executable",
+			audit: audit,
+			records: []paths.MetaRecord{
+				testRecord("/usr/bin/passwd", os.ModeSetuid|0o755),
+				testRecord("/usr/bin/sudo", os.ModeSetuid|0o111),
+			},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "unlisted setuid executable",
+			audit:    audit,
+			records:  []paths.MetaRecord{testRecord("/usr/local/bin/passwd", os.ModeSetuid|0o755)},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathSetIDExecutable,
+		},
+		{
+			name:     "allow-list entries match full paths only",
+			audit:    audit,
+			records:  []paths.MetaRecord{testRecord("/usr/bin/passwd.bak", os.ModeSetgid|0o755)},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathSetIDExecutable,
+		},
+		{
+			name:     "setuid file without execute bit",
+			audit:    audit,
+			records:  []paths.MetaRecord{testRecord("/srv/app/data", os.ModeSetuid|0o644)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "setuid checks disabled",
+			audit:    config.SecurityAudit{WorldWritableDirsCheck: true},
+			records:  []paths.MetaRecord{testRecord("/usr/local/bin/tool", os.ModeSetuid|0o755)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "world-writable directory without sticky bit",
+			audit:    audit,
+			records:  []paths.MetaRecord{testRecord("/srv/app/upload", os.ModeDir|0o777)},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathWorldWritableDir,
+		},
+		{
+			name:     "world-writable file",
+			audit:    audit,
+			records:  []paths.MetaRecord{testRecord("/srv/app/upload.log", 0o666)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:  "critical findings take precedence",
+			audit: audit,
+			records: []paths.MetaRecord{
+				testRecord("/srv/app/upload", os.ModeDir|0o777),
+				testRecord("/usr/local/bin/tool", os.ModeSetuid|0o755),
+			},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathSetIDExecutable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := nagios.NewPlugin()
+			logger := zerolog.Nop()
+
+			err := checkSecurityAudit("/", tt.audit, &logger, plugin, tt.records...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if plugin.ExitStatusCode != tt.wantCode {
+				t.Errorf("got exit code %d; want %d", plugin.ExitStatusCode, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+// maxListedPaths is the maximum number of offending paths recorded in the
+// LongServiceOutput for checks which report on individual items within a
+// specified path. This helps keep notifications readable for large trees.
+const maxListedPaths int = 10
@@ -0,0 +1,144 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkCount is a helper variadic function that accepts one or many
+// MetaRecord values for file count evaluation. If the specified count
+// threshold values are crossed, the provided *nagios.Plugin is updated and an
+// error is returned to signal that this specific check has found too many or
+// too few files.
+func checkCount(path string, ths config.FileCountThresholdsMinMax, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	// type conversion to expose desired methods
+	metaRecords := paths.MetaRecords(mrs)
+
+	// NOTE: Directories (themselves) are not included in the count, just the
+	// files within said directories.
+	actualCount := metaRecords.FileCount()
+
+	// warning threshold required, so we can use that to reduce
+	// conditional check logic complexity
+	if (ths.CountMin.Set && actualCount < ths.CountMin.Warning) ||
+		(ths.CountMax.Set && actualCount > ths.CountMax.Warning) {
+
+		fileCountTooLargeErr := fmt.Errorf(
+			"%w (%d evaluated)",
+			paths.ErrFileCountTooLarge,
+			len(metaRecords),
+		)
+
+		fileCountTooSmallErr := fmt.Errorf(
+			"%w (%d evaluated)",
+			paths.ErrFileCountTooSmall,
+			len(metaRecords),
+		)
+
+		serviceOutputTmpl := fmt.Sprintf(
+			"file count threshold crossed; %d files found in path %q",
+			actualCount,
+			path,
+		)
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Count %s** path: %q%s** files: %d%s",
+			nagios.CheckOutputEOL,
+			path,
+			nagios.CheckOutputEOL,
+			actualCount,
+			nagios.CheckOutputEOL,
+		)
+
+		var stateLabel string
+		var exitCode int
+
+		// configure exit state details based on how the thresholds were
+		// crossed. return after all exit state details are recorded
+		switch {
+
+		case ths.CountMax.Set &&
+			(actualCount > ths.CountMax.Critical || actualCount > ths.CountMax.Warning):
+			zlog.Error().Err(fileCountTooLargeErr).
+				Int("critical_count_max", ths.CountMax.Critical).
+				Int("warning_count_max", ths.CountMax.Warning).
+				Int("actual_count", actualCount).
+				Bool("count_max_check_enabled", ths.CountMax.Set).
+				Str("path", path).
+				Msg(fileCountTooLargeErr.Error())
+
+			nes.AddError(fileCountTooLargeErr)
+
+			switch {
+			case actualCount > ths.CountMax.Critical:
+				stateLabel = nagios.StateCRITICALLabel
+				exitCode = nagios.StateCRITICALExitCode
+
+			case actualCount > ths.CountMax.Warning:
+				stateLabel = nagios.StateWARNINGLabel
+				exitCode = nagios.StateWARNINGExitCode
+			}
+
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: %s %s",
+				stateLabel,
+				ths.CountMax.Description,
+				serviceOutputTmpl,
+			)
+
+			nes.ExitStatusCode = exitCode
+
+			return fileCountTooLargeErr
+
+		case ths.CountMin.Set &&
+			(actualCount < ths.CountMin.Critical || actualCount < ths.CountMin.Warning):
+			zlog.Error().Err(fileCountTooSmallErr).
+				Int("critical_count_min", ths.CountMin.Critical).
+				Int("warning_count_min", ths.CountMin.Warning).
+				Int("actual_count", actualCount).
+				Bool("count_min_check_enabled", ths.CountMin.Set).
+				Str("path", path).
+				Msg(fileCountTooSmallErr.Error())
+
+			nes.AddError(fileCountTooSmallErr)
+
+			switch {
+			case actualCount < ths.CountMin.Critical:
+				stateLabel = nagios.StateCRITICALLabel
+				exitCode = nagios.StateCRITICALExitCode
+
+			case actualCount < ths.CountMin.Warning:
+				stateLabel = nagios.StateWARNINGLabel
+				exitCode = nagios.StateWARNINGExitCode
+			}
+
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: %s %s",
+				stateLabel,
+				ths.CountMin.Description,
+				serviceOutputTmpl,
+			)
+
+			nes.ExitStatusCode = exitCode
+
+			return fileCountTooSmallErr
+
+		}
+
+	}
+
+	return nil
+
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkEmptiness is a helper variadic function that accepts one or many
+// MetaRecord values for evaluation of whether the specified directory is
+// empty. Content which is ignored or excluded by request is not collected and
+// so does not count towards the directory being non-empty. If the requested
+// assertion fails, the provided *nagios.Plugin is updated and an error is
+// returned to signal that this specific check has found a directory which is
+// empty or not empty contrary to what was expected. Subdirectories are not
+// reported by paths.Process unless recursion is enabled, so the entries
+// directly within the specified directory are read separately if it is not.
+func checkEmptiness(path string, emptiness config.DirectoryEmptiness, opts paths.ProcessOptions, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	for _, record := range mrs {
+		if record.Depth != 0 || record.IsDir() {
+			continue
+		}
+
+		notDirErr := fmt.Errorf("%w: %s", paths.ErrPathNotDirectory, record.FQPath)
+
+		zlog.Error().Err(notDirErr).
+			Str("path", path).
+			Msg("emptiness assertions apply only to directories")
+
+		nes.AddError(notDirErr)
+		nes.ServiceOutput = fmt.Sprintf(
+			"%s: emptiness assertions apply only to directories; %q is not a directory",
+			nagios.StateUNKNOWNLabel,
+			path,
+		)
+		nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+		return notDirErr
+	}
+
+	content := paths.MetaRecords(mrs).Content()
+
+	if !opts.Recurse {
+		direct, err := paths.DirectContent(path, opts)
+		if err != nil {
+			zlog.Error().Err(err).
+				Str("path", path).
+				Msg("failed to read directory content")
+
+			nes.AddError(err)
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: failed to read content of directory %q",
+				nagios.StateUNKNOWNLabel,
+				path,
+			)
+			nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+			return err
+		}
+
+		content = direct
+	}
+
+	var emptinessErr error
+	var critical bool
+	var problem string
+
+	switch {
+	case emptiness.EmptyCheck && len(content) > 0:
+		emptinessErr = paths.ErrDirectoryNotEmpty
+		critical = emptiness.EmptyCritical
+		problem = fmt.Sprintf("%d items found in directory expected to be empty", len(content))
+
+	case emptiness.NotEmptyCheck && len(content) == 0:
+		emptinessErr = paths.ErrDirectoryEmpty
+		critical = emptiness.NotEmptyCritical
+		problem = "no items found in directory expected to not be empty"
+
+	default:
+		return nil
+	}
+
+	zlog.Error().Err(emptinessErr).
+		Bool("assert_empty_check_enabled", emptiness.EmptyCheck).
+		Bool("assert_not_empty_check_enabled", emptiness.NotEmptyCheck).
+		Int("items", len(content)).
+		Str("path", path).
+		Msg(problem)
+
+	nes.AddError(fmt.Errorf("%w: %s", emptinessErr, path))
+
+	for i, record := range content {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Emptiness: %d additional items omitted%s",
+				len(content)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Emptiness %s** path: %q%s** size: %s%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			record.SizeHR(),
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	stateLabel := nagios.StateWARNINGLabel
+	exitCode := nagios.StateWARNINGExitCode
+	if critical {
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %s %q",
+		stateLabel,
+		problem,
+		path,
+	)
+	nes.ExitStatusCode = exitCode
+
+	return emptinessErr
+
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkFileSize is a helper variadic function that accepts one or many
+// MetaRecord values for per-file size evaluation. If any individual file
+// crosses the specified size threshold values, the provided *nagios.Plugin
+// is updated with the largest offending files and an error is returned to
+// signal that this specific check has found files which are too large. The
+// specified size source determines whether apparent or allocated file sizes
+// are used.
+func checkFileSize(path string, ths config.FileSizeThresholds, sizeSource string, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	// Collect offending files separately so that sorting them does not
+	// modify the order of the provided MetaRecord values.
+	var tooLarge paths.MetaRecords
+	for _, record := range mrs {
+
+		// skip size check for directories
+		if record.IsDir() {
+			continue
+		}
+
+		if record.SizeOf(sizeSource) > ths.Warning {
+			tooLarge = append(tooLarge, record)
+		}
+	}
+
+	if len(tooLarge) == 0 {
+		return nil
+	}
+
+	sort.Slice(tooLarge, func(i, j int) bool {
+		return tooLarge[i].SizeOf(sizeSource) > tooLarge[j].SizeOf(sizeSource)
+	})
+
+	largest := tooLarge[0]
+
+	var stateLabel string
+	var exitCode int
+	var threshold int64
+	var numFailed int
+
+	switch {
+	case largest.SizeOf(sizeSource) > ths.Critical:
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+		threshold = ths.Critical
+
+		for _, record := range tooLarge {
+			if record.SizeOf(sizeSource) > ths.Critical {
+				numFailed++
+			}
+		}
+
+	default:
+		stateLabel = nagios.StateWARNINGLabel
+		exitCode = nagios.StateWARNINGExitCode
+		threshold = ths.Warning
+		numFailed = len(tooLarge)
+	}
+
+	fileTooLargeErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		numFailed,
+		len(mrs),
+		paths.ErrFileTooLarge,
+	)
+
+	zlog.Error().Err(fileTooLargeErr).
+		Int64("critical_file_size_max_bytes", ths.Critical).
+		Int64("warning_file_size_max_bytes", ths.Warning).
+		Int64("largest_file_size_bytes", largest.SizeOf(sizeSource)).
+		Str("largest_file", largest.FQPath).
+		Bool("file_size_max_check_enabled", ths.Set).
+		Str("path", path).
+		Msg(fileTooLargeErr.Error())
+
+	nes.AddError(fileTooLargeErr)
+
+	// List the top offenders, largest first.
+	for i, record := range tooLarge {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* File size: %d additional files omitted%s",
+				len(tooLarge)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* File size %s** path: %q%s** bytes: %v%s** human-readable: %v%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			record.SizeOf(sizeSource),
+			nagios.CheckOutputEOL,
+			units.ByteCountIEC(record.SizeOf(sizeSource)),
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %d files larger than %s (%s) found; largest is %q (%s) [path: %q]",
+		stateLabel,
+		numFailed,
+		units.ByteCountIEC(threshold),
+		ths.Description,
+		largest.FQPath,
+		units.ByteCountIEC(largest.SizeOf(sizeSource)),
+		path,
+	)
+
+	nes.ExitStatusCode = exitCode
+
+	return fileTooLargeErr
+
+}
@@ -0,0 +1,275 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkFilesystems is a helper function that evaluates free space and free
+// inodes for each distinct filesystem hosting the specified paths. Usage
+// details and performance data are recorded for every filesystem. If the
+// specified threshold values are crossed, the provided *nagios.Plugin is
+// updated and an error is returned to signal that this specific check has
+// found a filesystem running low on space or inodes.
+//
+// Missing paths are skipped; these are handled by the evaluation of each
+// specified path.
+func checkFilesystems(pathsList []string, ths config.FilesystemThresholds, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	mounts, err := paths.Mounts()
+	if err != nil {
+		return filesystemCheckFailed(err, zlog, nes)
+	}
+
+	seenDevices := make(map[uint64]struct{})
+	filesystems := make([]paths.FilesystemStats, 0, len(pathsList))
+
+	for _, path := range pathsList {
+		fsStats, err := paths.FilesystemInfo(path, mounts)
+		switch {
+		case errors.Is(err, paths.ErrPathDoesNotExist):
+			continue
+		case err != nil:
+			return filesystemCheckFailed(err, zlog, nes)
+		}
+
+		if _, seen := seenDevices[fsStats.Device]; seen {
+			continue
+		}
+		seenDevices[fsStats.Device] = struct{}{}
+
+		filesystems = append(filesystems, fsStats)
+	}
+
+	var worstState int
+	var worstErr error
+	var worstOutput string
+
+	for _, fsStats := range filesystems {
+
+		recordFilesystemDetails(fsStats, ths, zlog, nes)
+
+		// critical state results are given priority over warning state
+		// results when evaluating multiple filesystems
+		for _, result := range []struct {
+			ths    config.FilesystemFreeThresholds
+			actual float64
+			err    error
+			label  string
+			skip   bool
+		}{
+			{
+				ths:    ths.FreeBytes,
+				actual: float64(fsStats.FreeBytes),
+				err:    paths.ErrFilesystemLowSpace,
+				label:  units.ByteCountIEC(int64(fsStats.FreeBytes)) + " free",
+				skip:   !fsStats.SpaceReported(),
+			},
+			{
+				ths:    ths.FreePercent,
+				actual: fsStats.FreePercent(),
+				err:    paths.ErrFilesystemLowSpace,
+				label:  fmt.Sprintf("%.2f%% free", fsStats.FreePercent()),
+				skip:   !fsStats.SpaceReported(),
+			},
+			{
+				ths:    ths.InodesFreePercent,
+				actual: fsStats.InodesFreePercent(),
+				err:    paths.ErrFilesystemLowInodes,
+				label:  fmt.Sprintf("%.2f%% inodes free", fsStats.InodesFreePercent()),
+				skip:   !fsStats.InodesReported(),
+			},
+		} {
+			if !result.ths.Set || result.skip || result.actual >= result.ths.Warning {
+				continue
+			}
+
+			state := nagios.StateWARNINGExitCode
+			stateLabel := nagios.StateWARNINGLabel
+			if result.actual < result.ths.Critical {
+				state = nagios.StateCRITICALExitCode
+				stateLabel = nagios.StateCRITICALLabel
+			}
+
+			fsErr := fmt.Errorf(
+				"%w (%s on %s mounted at %s)",
+				result.err,
+				result.label,
+				fsStats.Mount.Source,
+				fsStats.Mount.MountPoint,
+			)
+
+			zlog.Error().Err(fsErr).
+				Float64("critical", result.ths.Critical).
+				Float64("warning", result.ths.Warning).
+				Float64("actual", result.actual).
+				Str("threshold", result.ths.Description).
+				Str("device", fsStats.Mount.Source).
+				Str("mount_point", fsStats.Mount.MountPoint).
+				Str("path", fsStats.Path).
+				Msg("filesystem threshold crossed")
+
+			nes.AddError(fsErr)
+
+			if state > worstState {
+				worstState = state
+				worstErr = result.err
+				worstOutput = fmt.Sprintf(
+					"%s: %s threshold crossed; %s on %q mounted at %q [path: %q]",
+					stateLabel,
+					result.ths.Description,
+					result.label,
+					fsStats.Mount.Source,
+					fsStats.Mount.MountPoint,
+					fsStats.Path,
+				)
+			}
+		}
+	}
+
+	if worstErr != nil {
+		nes.ServiceOutput = worstOutput
+		nes.ExitStatusCode = worstState
+
+		return worstErr
+	}
+
+	return nil
+
+}
+
+// recordFilesystemDetails records usage details and performance data for the
+// given filesystem.
+func recordFilesystemDetails(fsStats paths.FilesystemStats, ths config.FilesystemThresholds, zlog *zerolog.Logger, nes *nagios.Plugin) {
+
+	space := "N/A (not reported by filesystem)"
+	if fsStats.SpaceReported() {
+		space = fmt.Sprintf(
+			"%s of %s (%.2f%%)",
+			units.ByteCountIEC(int64(fsStats.FreeBytes)),
+			units.ByteCountIEC(int64(fsStats.TotalBytes)),
+			fsStats.FreePercent(),
+		)
+	}
+
+	inodes := "N/A (not reported by filesystem)"
+	if fsStats.InodesReported() {
+		inodes = fmt.Sprintf(
+			"%d of %d (%.2f%%)",
+			fsStats.FreeInodes,
+			fsStats.TotalInodes,
+			fsStats.InodesFreePercent(),
+		)
+	}
+
+	nes.LongServiceOutput += fmt.Sprintf(
+		"* Filesystem for %q%s** device: %q%s** mount point: %q%s** type: %q%s** free: %s%s** inodes free: %s%s",
+		fsStats.Path,
+		nagios.CheckOutputEOL,
+		fsStats.Mount.Source,
+		nagios.CheckOutputEOL,
+		fsStats.Mount.MountPoint,
+		nagios.CheckOutputEOL,
+		fsStats.Mount.FSType,
+		nagios.CheckOutputEOL,
+		space,
+		nagios.CheckOutputEOL,
+		inodes,
+		nagios.CheckOutputEOL,
+	)
+
+	// thresholds are expressed as ranges; a value below the threshold is
+	// considered a problem
+	thresholdRange := func(th config.FilesystemFreeThresholds, value float64) string {
+		if !th.Set {
+			return ""
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64) + ":"
+	}
+
+	var perfData []nagios.PerformanceData
+
+	if fsStats.SpaceReported() {
+		perfData = append(perfData, nagios.PerformanceData{
+			Label:             perfDataLabel(fsStats.Mount.MountPoint, "free"),
+			Value:             strconv.FormatUint(fsStats.FreeBytes, 10),
+			UnitOfMeasurement: "B",
+			Warn:              thresholdRange(ths.FreeBytes, ths.FreeBytes.Warning),
+			Crit:              thresholdRange(ths.FreeBytes, ths.FreeBytes.Critical),
+			Min:               "0",
+			Max:               strconv.FormatUint(fsStats.TotalBytes, 10),
+		}, nagios.PerformanceData{
+			Label:             perfDataLabel(fsStats.Mount.MountPoint, "free_pct"),
+			Value:             strconv.FormatFloat(fsStats.FreePercent(), 'f', 2, 64),
+			UnitOfMeasurement: "%",
+			Warn:              thresholdRange(ths.FreePercent, ths.FreePercent.Warning),
+			Crit:              thresholdRange(ths.FreePercent, ths.FreePercent.Critical),
+		})
+	}
+
+	if fsStats.InodesReported() {
+		perfData = append(perfData, nagios.PerformanceData{
+			Label:             perfDataLabel(fsStats.Mount.MountPoint, "inodes_free_pct"),
+			Value:             strconv.FormatFloat(fsStats.InodesFreePercent(), 'f', 2, 64),
+			UnitOfMeasurement: "%",
+			Warn:              thresholdRange(ths.InodesFreePercent, ths.InodesFreePercent.Warning),
+			Crit:              thresholdRange(ths.InodesFreePercent, ths.InodesFreePercent.Critical),
+		})
+	}
+
+	if len(perfData) == 0 {
+		return
+	}
+
+	if err := nes.AddPerfData(false, perfData...); err != nil {
+		zlog.Error().Err(err).
+			Str("mount_point", fsStats.Mount.MountPoint).
+			Msg("failed to add performance data")
+	}
+
+}
+
+// perfDataLabelReplacer replaces the characters which are not permitted in
+// performance data labels.
+var perfDataLabelReplacer = strings.NewReplacer("'", "_", "=", "_")
+
+// perfDataLabel returns the performance data label for the given metric of
+// the filesystem mounted at the given mount point. Characters in the mount
+// point which are not permitted in labels (' and =) are replaced with
+// underscores.
+func perfDataLabel(mountPoint string, metric string) string {
+	return fmt.Sprintf("fs_%s_%s", perfDataLabelReplacer.Replace(mountPoint), metric)
+}
+
+// filesystemCheckFailed is a helper function used to record an UNKNOWN state
+// if filesystem details could not be retrieved.
+func filesystemCheckFailed(err error, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	zlog.Error().Err(err).Msg("failed to retrieve filesystem details")
+
+	nes.AddError(err)
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: failed to retrieve filesystem details: %v",
+		nagios.StateUNKNOWNLabel,
+		err,
+	)
+	nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+	return err
+
+}
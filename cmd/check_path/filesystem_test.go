@@ -0,0 +1,37 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/atc0005/go-nagios"
+)
+
+func TestPerfDataLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"/":                "fs_/_free",
+		"/srv/shared data": "fs_/srv/shared data_free",
+		"/mnt/o'brien":     "fs_/mnt/o_brien_free",
+		"/mnt/key=value":   "fs_/mnt/key_value_free",
+	}
+
+	for mountPoint, want := range tests {
+		got := perfDataLabel(mountPoint, "free")
+		if got != want {
+			t.Errorf("perfDataLabel(%q) = %q; want %q", mountPoint, got, want)
+		}
+
+		pd := nagios.PerformanceData{Label: got, Value: "0"}
+		if err := pd.Validate(); err != nil {
+			t.Errorf("label %q for mount point %q fails validation: %v", got, mountPoint, err)
+		}
+	}
+}
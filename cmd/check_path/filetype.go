@@ -0,0 +1,163 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkFileTypes is a helper variadic function that accepts one or many
+// MetaRecord values for file type evaluation. The type of the specified path
+// itself is asserted against the list of permitted path types while the type
+// of content within the specified path is asserted against the allow and
+// deny lists. If an unexpected file type is found, the provided
+// *nagios.Plugin is updated and an error is returned to signal that this
+// specific check has found a file of an unexpected type.
+func checkFileTypes(path string, fileTypes config.FileTypeAssertions, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var unexpected []paths.MetaRecord
+
+	for _, record := range mrs {
+		fileType := record.FileType()
+
+		switch {
+		case record.Depth == 0:
+			if !fileTypes.PathCheck() || textutils.InList(fileType, fileTypes.PathTypes) {
+				continue
+			}
+
+			pathTypeErr := fmt.Errorf(
+				"%w: %s is of type %s; expected one of %v",
+				paths.ErrPathUnexpectedType,
+				record.FQPath,
+				fileType,
+				fileTypes.PathTypes,
+			)
+
+			zlog.Error().Err(pathTypeErr).
+				Str("path", path).
+				Str("type", fileType).
+				Strs("expected_types", fileTypes.PathTypes).
+				Msg("specified path is not of the expected type")
+
+			nes.AddError(pathTypeErr)
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* File type %s** path: %q%s** type: %s%s** expected: %v%s",
+				nagios.CheckOutputEOL,
+				record.FQPath,
+				nagios.CheckOutputEOL,
+				fileType,
+				nagios.CheckOutputEOL,
+				fileTypes.PathTypes,
+				nagios.CheckOutputEOL,
+			)
+
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: specified path %q is of type %s; expected one of %v",
+				nagios.StateCRITICALLabel,
+				path,
+				fileType,
+				fileTypes.PathTypes,
+			)
+			nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+			return pathTypeErr
+
+		case !fileTypes.ContentCheck():
+			continue
+
+		case textutils.InList(fileType, fileTypes.Denied):
+			unexpected = append(unexpected, record)
+
+		case len(fileTypes.Allowed) > 0 && !textutils.InList(fileType, fileTypes.Allowed):
+			unexpected = append(unexpected, record)
+		}
+	}
+
+	if len(unexpected) == 0 {
+		return nil
+	}
+
+	// count of each unexpected type in order to summarize what was found
+	typeCounts := make(map[string]int)
+	var typesFound []string
+	for _, record := range unexpected {
+		fileType := record.FileType()
+		if typeCounts[fileType] == 0 {
+			typesFound = append(typesFound, fileType)
+		}
+		typeCounts[fileType]++
+	}
+
+	summary := make([]string, 0, len(typesFound))
+	for _, fileType := range typesFound {
+		summary = append(summary, fmt.Sprintf("%d %s", typeCounts[fileType], fileType))
+	}
+
+	fileTypeErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		len(unexpected),
+		len(mrs),
+		paths.ErrFileTypeUnexpected,
+	)
+
+	zlog.Error().Err(fileTypeErr).
+		Strs("allowed_types", fileTypes.Allowed).
+		Strs("denied_types", fileTypes.Denied).
+		Strs("found_types", typesFound).
+		Str("path", path).
+		Msg("unexpected file types found")
+
+	nes.AddError(fileTypeErr)
+
+	for i, record := range unexpected {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* File type: %d additional items omitted%s",
+				len(unexpected)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* File type %s** path: %q%s** type: %s%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			record.FileType(),
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	stateLabel := nagios.StateWARNINGLabel
+	exitCode := nagios.StateWARNINGExitCode
+	if fileTypes.Critical {
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: unexpected file types found in path %q (%s)",
+		stateLabel,
+		path,
+		strings.Join(summary, ", "),
+	)
+	nes.ExitStatusCode = exitCode
+
+	return fileTypeErr
+
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkAgeNewest is a helper variadic function that accepts one or many
+// MetaRecord values for evaluation of the newest file, as determined by the
+// specified timestamp source (e.g., modification time). If the newest file
+// is older than the specified threshold values (or no files are present),
+// the provided *nagios.Plugin is updated and an error is returned to signal
+// that this specific check has found stale content.
+//
+// Since the newest file could be any file in the specified path, this check
+// is only reliable after all content in the path has been collected.
+func checkAgeNewest(path string, ths config.FileAgeThresholds, tsSource string, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	files, tsErr := ageFiles(path, tsSource, zlog, nes, mrs)
+	if tsErr != nil {
+		return tsErr
+	}
+
+	if len(files) == 0 {
+		noFilesErr := fmt.Errorf(
+			"0 files found: %w",
+			paths.ErrPathNoRecentFiles,
+		)
+
+		zlog.Error().Err(noFilesErr).
+			Dur("critical_age_newest", ths.Critical).
+			Dur("warning_age_newest", ths.Warning).
+			Str("path", path).
+			Msg("no files found")
+
+		nes.AddError(noFilesErr)
+
+		nes.ServiceOutput = fmt.Sprintf(
+			"%s: no files found; expected file newer than %s [path: %q]",
+			nagios.StateCRITICALLabel,
+			units.FormatDuration(ths.Critical),
+			path,
+		)
+
+		nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+		return paths.ErrPathNoRecentFiles
+	}
+
+	files.SortByTimestampDesc()
+
+	newest := files[0]
+	now := time.Now()
+	criticalThreshold := now.Add(-ths.Critical)
+	warningThreshold := now.Add(-ths.Warning)
+
+	if !newest.Timestamp.Before(warningThreshold) {
+		return nil
+	}
+
+	fileAge := now.Sub(newest.Timestamp)
+
+	zlog.Error().Err(paths.ErrPathNoRecentFiles).
+		Dur("critical_age_newest", ths.Critical).
+		Dur("warning_age_newest", ths.Warning).
+		Str("age_timestamp", tsSource).
+		Bool("age_newest_check_enabled", ths.Set).
+		Str("newest_file", newest.FQPath).
+		Dur("newest_file_age", fileAge).
+		Str("path", path).
+		Msg("no recently modified files found")
+
+	nes.AddError(fmt.Errorf(
+		"%d files evaluated: %w",
+		len(files),
+		paths.ErrPathNoRecentFiles,
+	))
+
+	nes.LongServiceOutput += fmt.Sprintf(
+		"* Newest file %s** parent dir: %q%s** name: %q%s** depth: %d%s** %s: %v%s** age: %s%s",
+		nagios.CheckOutputEOL,
+		newest.ParentDir,
+		nagios.CheckOutputEOL,
+		newest.Name(),
+		nagios.CheckOutputEOL,
+		newest.Depth,
+		nagios.CheckOutputEOL,
+		tsSource,
+		newest.Timestamp.Format(time.RFC3339),
+		nagios.CheckOutputEOL,
+		units.FormatDuration(fileAge),
+		nagios.CheckOutputEOL,
+	)
+
+	var stateLabel string
+	var limit time.Duration
+
+	switch {
+	case newest.Timestamp.Before(criticalThreshold):
+		stateLabel = nagios.StateCRITICALLabel
+		limit = ths.Critical
+		nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+	default:
+		stateLabel = nagios.StateWARNINGLabel
+		limit = ths.Warning
+		nes.ExitStatusCode = nagios.StateWARNINGExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: newest file older than %s (%s) [path: %q]",
+		stateLabel,
+		units.FormatDuration(limit),
+		units.FormatDuration(fileAge),
+		path,
+	)
+
+	return paths.ErrPathNoRecentFiles
+
+}
@@ -0,0 +1,220 @@
+//go:build !windows
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// unknownID is a uid and gid value without a matching user or group entry.
+const unknownID = 4000000
+
+// ownedRecord returns a MetaRecord for the given path owned by the given uid
+// and gid.
+func ownedRecord(path string, uid uint32, gid uint32) paths.MetaRecord {
+	return paths.MetaRecord{
+		FileInfo: testFileInfo{
+			name: path,
+			mode: 0o644,
+			sys:  &syscall.Stat_t{Uid: uid, Gid: gid},
+		},
+		FQPath: path,
+	}
+}
+
+func TestResolvePermittedIDsUnknownNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		resolveIDs config.ResolveIDs
+	}{
+		{
+			name:       "unknown username",
+			resolveIDs: config.ResolveIDs{IDs: config.IDs{Usernames: []string{"check-path-no-such-user"}}},
+		},
+		{
+			name:       "signed numeric username",
+			resolveIDs: config.ResolveIDs{IDs: config.IDs{Usernames: []string{"+1001"}}},
+		},
+		{
+			name:       "unknown group name",
+			resolveIDs: config.ResolveIDs{IDs: config.IDs{GroupNames: []string{"check-path-no-such-group"}}},
+		},
+		{
+			name:       "unknown owner group",
+			resolveIDs: config.ResolveIDs{IDs: config.IDs{OwnerGroups: []string{"check-path-no-such-group"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := zerolog.Nop()
+			if _, err := resolvePermittedIDs(tt.resolveIDs, &logger); err == nil {
+				t.Error("expected error for unknown name")
+			}
+		})
+	}
+}
+
+func TestCheckIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		resolveIDs config.ResolveIDs
+		permitted  permittedIDs
+		records    []paths.MetaRecord
+		wantCode   int
+		wantErr    error
+	}{
+		{
+			name:       "uid in allow list",
+			resolveIDs: config.ResolveIDs{UsernameCheck: true, UsernameCritical: true},
+			permitted:  permittedIDs{UIDs: []int{0, 1001}},
+			records: []paths.MetaRecord{
+				ownedRecord("/srv/app/a", 0, 0),
+				ownedRecord("/srv/app/b", 1001, 0),
+			},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:       "uid not in allow list",
+			resolveIDs: config.ResolveIDs{UsernameCheck: true, UsernameCritical: true},
+			permitted:  permittedIDs{UIDs: []int{1001}},
+			records:    []paths.MetaRecord{ownedRecord("/srv/app/a", unknownID, 0)},
+			wantCode:   nagios.StateCRITICALExitCode,
+			wantErr:    paths.ErrPathMissingUsername,
+		},
+		{
+			name:       "gid in allow list",
+			resolveIDs: config.ResolveIDs{GroupNameCheck: true, GroupNameWarning: true},
+			permitted:  permittedIDs{GIDs: []int{0, 33}},
+			records:    []paths.MetaRecord{ownedRecord("/srv/app/a", unknownID, 33)},
+			wantCode:   nagios.StateOKExitCode,
+		},
+		{
+			name:       "gid not in allow list",
+			resolveIDs: config.ResolveIDs{GroupNameCheck: true, GroupNameWarning: true},
+			permitted:  permittedIDs{GIDs: []int{33}},
+			records: []paths.MetaRecord{
+				ownedRecord("/srv/app/a", 0, 33),
+				ownedRecord("/srv/app/b", 0, unknownID),
+			},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathMissingGroupName,
+		},
+		{
+			name: "username checked before group name",
+			resolveIDs: config.ResolveIDs{
+				UsernameCheck:     true,
+				UsernameWarning:   true,
+				GroupNameCheck:    true,
+				GroupNameCritical: true,
+			},
+			permitted: permittedIDs{UIDs: []int{0}, GIDs: []int{0}},
+			records:   []paths.MetaRecord{ownedRecord("/srv/app/a", unknownID, unknownID)},
+			wantCode:  nagios.StateWARNINGExitCode,
+			wantErr:   paths.ErrPathMissingUsername,
+		},
+		{
+			name:       "owner of unknown uid not a group member",
+			resolveIDs: config.ResolveIDs{OwnerGroupCheck: true, OwnerGroupCritical: true},
+			permitted:  permittedIDs{OwnerGIDs: []int{0}},
+			records:    []paths.MetaRecord{ownedRecord("/srv/app/a", unknownID, 0)},
+			wantCode:   nagios.StateCRITICALExitCode,
+			wantErr:    paths.ErrPathOwnerNotGroupMember,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := nagios.NewPlugin()
+			logger := zerolog.Nop()
+
+			err := checkIDs("/srv/app", tt.resolveIDs, tt.permitted, &logger, plugin, tt.records...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if plugin.ExitStatusCode != tt.wantCode {
+				t.Errorf("got exit code %d; want %d", plugin.ExitStatusCode, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCheckOrphanedOwners(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		orphaned config.OrphanedOwners
+		records  []paths.MetaRecord
+		wantCode int
+		wantErr  error
+	}{
+		{
+			name:     "known owner and group",
+			orphaned: config.OrphanedOwners{Check: true, Critical: true},
+			records:  []paths.MetaRecord{ownedRecord("/srv/app/a", 0, 0)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "unknown owner",
+			orphaned: config.OrphanedOwners{Check: true, Critical: true},
+			records: []paths.MetaRecord{
+				ownedRecord("/srv/app/a", 0, 0),
+				ownedRecord("/srv/app/b", unknownID, 0),
+			},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathOrphanedOwner,
+		},
+		{
+			name:     "unknown group",
+			orphaned: config.OrphanedOwners{Check: true, Warning: true},
+			records:  []paths.MetaRecord{ownedRecord("/srv/app/a", 0, unknownID)},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathOrphanedOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := nagios.NewPlugin()
+			logger := zerolog.Nop()
+
+			err := checkOrphanedOwners("/srv/app", tt.orphaned, &logger, plugin, tt.records...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if plugin.ExitStatusCode != tt.wantCode {
+				t.Errorf("got exit code %d; want %d", plugin.ExitStatusCode, tt.wantCode)
+			}
+		})
+	}
+}
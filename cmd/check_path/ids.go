@@ -9,19 +9,113 @@ package main
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/atc0005/check-path/internal/config"
 	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
 	"github.com/atc0005/go-nagios"
 	"github.com/rs/zerolog"
 )
 
+// permittedIDs represents the permitted usernames, group names and owner
+// groups resolved to numeric user and group IDs.
+type permittedIDs struct {
+	UIDs      []int
+	GIDs      []int
+	OwnerGIDs []int
+}
+
+// resolvePermittedIDs resolves the permitted usernames and group names to
+// numeric IDs once so that ownership of each evaluated file can be compared
+// using numeric IDs. Numeric values (digits only) are used as-is. An error is
+// returned for names without a matching user or group entry.
+func resolvePermittedIDs(resolveIDs config.ResolveIDs, zlog *zerolog.Logger) (permittedIDs, error) {
+
+	var permitted permittedIDs
+
+	for _, username := range resolveIDs.Usernames {
+		if textutils.IsDigits(username) {
+			uid, err := strconv.Atoi(username)
+			if err != nil {
+				return permittedIDs{}, fmt.Errorf("invalid user ID %q: %w", username, err)
+			}
+			permitted.UIDs = append(permitted.UIDs, uid)
+			continue
+		}
+
+		uid, found, err := paths.LookupUserID(username)
+		switch {
+		case err != nil:
+			return permittedIDs{}, err
+		case !found:
+			return permittedIDs{}, fmt.Errorf("permitted username %q not found", username)
+		}
+
+		permitted.UIDs = append(permitted.UIDs, uid)
+	}
+
+	var err error
+
+	permitted.GIDs, err = resolvePermittedGroups(resolveIDs.GroupNames)
+	if err != nil {
+		return permittedIDs{}, err
+	}
+
+	permitted.OwnerGIDs, err = resolvePermittedGroups(resolveIDs.OwnerGroups)
+	if err != nil {
+		return permittedIDs{}, err
+	}
+
+	zlog.Debug().
+		Ints("permitted_uids", permitted.UIDs).
+		Ints("permitted_gids", permitted.GIDs).
+		Ints("permitted_owner_gids", permitted.OwnerGIDs).
+		Msg("Resolved permitted usernames and group names")
+
+	return permitted, nil
+}
+
+// resolvePermittedGroups resolves the given group names to numeric group
+// IDs. Numeric values (digits only) are used as-is. An error is returned for
+// names without a matching group entry.
+func resolvePermittedGroups(groupNames []string) ([]int, error) {
+
+	var gids []int
+
+	for _, groupName := range groupNames {
+		if textutils.IsDigits(groupName) {
+			gid, err := strconv.Atoi(groupName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group ID %q: %w", groupName, err)
+			}
+			gids = append(gids, gid)
+			continue
+		}
+
+		gid, found, err := paths.LookupGroupID(groupName)
+		switch {
+		case err != nil:
+			return nil, err
+		case !found:
+			return nil, fmt.Errorf("permitted group name %q not found", groupName)
+		}
+
+		gids = append(gids, gid)
+	}
+
+	return gids, nil
+}
+
 // checkIDs is a helper variadic function that accepts one or many MetaRecord
-// values for username, group name evaluation. If the specified values are not
+// values for username, group name and owner group membership evaluation.
+// Ownership is compared using the permitted numeric IDs; names are only
+// resolved for reporting mismatches. If the specified values are not
 // present, the provided *nagios.Plugin is updated and an error is returned
-// to signal that this specific check has found missing username or group name
-// values.
-func checkIDs(path string, resolveIDs config.ResolveIDs, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+// to signal that this specific check has found missing username or group
+// name values.
+func checkIDs(path string, resolveIDs config.ResolveIDs, permitted permittedIDs, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
 
 	// G601: Implicit memory aliasing in for loop. (gosec)
 	// for _, record := range mrs {
@@ -35,32 +129,43 @@ func checkIDs(path string, resolveIDs config.ResolveIDs, zlog *zerolog.Logger, n
 		// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
 		record := mrs[i]
 
-		zlog.Debug().Msg("Username, Group name resolution enabled")
+		uid, gid, ownerErr := paths.OwnerIDs(record)
+		if ownerErr != nil {
+			return idsCheckFailed(path, ownerErr, zlog, nes)
+		}
+
+		ownerNotMember := false
+		if resolveIDs.OwnerGroupCheck {
+			memberships, _, membershipErr := paths.GroupMemberships(uid)
+			if membershipErr != nil {
+				return idsCheckFailed(path, membershipErr, zlog, nes)
+			}
+
+			ownerNotMember = !slices.ContainsFunc(memberships, func(memberGID int) bool {
+				return slices.Contains(permitted.OwnerGIDs, memberGID)
+			})
+		}
+
+		if !(resolveIDs.UsernameCheck && !slices.Contains(permitted.UIDs, uid)) &&
+			!(resolveIDs.GroupNameCheck && !slices.Contains(permitted.GIDs, gid)) &&
+			!ownerNotMember {
+			continue
+		}
 
+		// Resolve names only for ownership mismatches; lookups are cached
+		// by paths.ResolveIDs.
 		resolveErr := paths.ResolveIDs(&record)
 		if resolveErr != nil {
-			zlog.Error().Err(resolveErr).
-				Str("path", path).
-				Msg(resolveErr.Error())
-
-			nes.AddError(resolveErr)
-			nes.ServiceOutput = fmt.Sprintf(
-				"%s: failed to resolve IDs: %v [path: %q]",
-				nagios.StateCRITICALLabel,
-				resolveErr.Error(),
-				path,
-			)
-
-			return resolveErr
+			return idsCheckFailed(path, resolveErr, zlog, nes)
 		}
 
-		if resolveIDs.UsernameCheck &&
-			record.Username != resolveIDs.Username {
+		if resolveIDs.UsernameCheck && !slices.Contains(permitted.UIDs, record.UID) {
 
 			statusMsg := fmt.Sprintf(
-				"found username %q; expected %q [path: %q]",
-				record.Username,
-				resolveIDs.Username,
+				"found username %q (uid %d); expected one of %q [path: %q]",
+				idName(record.Username, record.UnknownUser),
+				record.UID,
+				resolveIDs.Usernames,
 				record.FQPath,
 			)
 
@@ -95,13 +200,13 @@ func checkIDs(path string, resolveIDs config.ResolveIDs, zlog *zerolog.Logger, n
 			}
 		}
 
-		if resolveIDs.GroupNameCheck &&
-			record.GroupName != resolveIDs.GroupName {
+		if resolveIDs.GroupNameCheck && !slices.Contains(permitted.GIDs, record.GID) {
 
 			statusMsg := fmt.Sprintf(
-				"found group name %q; expected %q [path: %q]",
-				record.GroupName,
-				resolveIDs.GroupName,
+				"found group name %q (gid %d); expected one of %q [path: %q]",
+				idName(record.GroupName, record.UnknownGroup),
+				record.GID,
+				resolveIDs.GroupNames,
 				record.FQPath,
 			)
 
@@ -135,7 +240,164 @@ func checkIDs(path string, resolveIDs config.ResolveIDs, zlog *zerolog.Logger, n
 				return paths.ErrPathMissingGroupName
 			}
 		}
+
+		if ownerNotMember {
+
+			statusMsg := fmt.Sprintf(
+				"found owner %q (uid %d) not a member of %q [path: %q]",
+				idName(record.Username, record.UnknownUser),
+				record.UID,
+				resolveIDs.OwnerGroups,
+				record.FQPath,
+			)
+
+			zlog.Error().Err(paths.ErrPathOwnerNotGroupMember).
+				Bool("owner_group_check_enabled", resolveIDs.OwnerGroupCheck).
+				Str("path", path).
+				Msg(statusMsg)
+
+			nes.AddError(paths.ErrPathOwnerNotGroupMember)
+
+			switch {
+			case resolveIDs.OwnerGroupCritical:
+				nes.ServiceOutput = fmt.Sprintf(
+					"%s: %s",
+					nagios.StateCRITICALLabel,
+					statusMsg,
+				)
+				nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+				return paths.ErrPathOwnerNotGroupMember
+
+			case resolveIDs.OwnerGroupWarning:
+				nes.ServiceOutput = fmt.Sprintf(
+					"%s: %s",
+					nagios.StateWARNINGLabel,
+					statusMsg,
+				)
+				nes.ExitStatusCode = nagios.StateWARNINGExitCode
+
+				return paths.ErrPathOwnerNotGroupMember
+			}
+		}
 	}
 
 	return nil
 }
+
+// idsCheckFailed is a helper function used to record a CRITICAL state if
+// the user or group IDs for content in a specified path could not be
+// resolved.
+func idsCheckFailed(path string, err error, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	zlog.Error().Err(err).
+		Str("path", path).
+		Msg(err.Error())
+
+	nes.AddError(err)
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: failed to resolve IDs: %v [path: %q]",
+		nagios.StateCRITICALLabel,
+		err.Error(),
+		path,
+	)
+
+	return err
+}
+
+// idName returns the given user or group name or a placeholder value if the
+// associated ID does not have a matching user or group entry.
+func idName(name string, unknown bool) string {
+	if unknown {
+		return "unknown"
+	}
+
+	return name
+}
+
+// checkOrphanedOwners is a helper variadic function that accepts one or many
+// MetaRecord values for evaluation of content owned by a uid or gid without a
+// matching user or group entry (e.g., content left behind by a removed
+// account). If any are found, the provided *nagios.Plugin is updated and an
+// error is returned to signal that this specific check has found content
+// with orphaned ownership.
+func checkOrphanedOwners(path string, orphaned config.OrphanedOwners, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var orphanedFound []paths.MetaRecord
+
+	for i := range mrs {
+
+		// See checkIDs for why indexing is used here.
+		record := mrs[i]
+
+		resolveErr := paths.ResolveIDs(&record)
+		if resolveErr != nil {
+			return idsCheckFailed(path, resolveErr, zlog, nes)
+		}
+
+		if record.Orphaned() {
+			orphanedFound = append(orphanedFound, record)
+		}
+	}
+
+	if len(orphanedFound) == 0 {
+		return nil
+	}
+
+	orphanedErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		len(orphanedFound),
+		len(mrs),
+		paths.ErrPathOrphanedOwner,
+	)
+
+	zlog.Error().Err(orphanedErr).
+		Bool("orphaned_owner_check_enabled", orphaned.Check).
+		Str("path", path).
+		Msg("content owned by unknown user or group found")
+
+	nes.AddError(orphanedErr)
+
+	for i, record := range orphanedFound {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Orphaned owner: %d additional items omitted%s",
+				len(orphanedFound)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Orphaned owner %s** path: %q%s** user: %s (uid %d)%s** group: %s (gid %d)%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			idName(record.Username, record.UnknownUser),
+			record.UID,
+			nagios.CheckOutputEOL,
+			idName(record.GroupName, record.UnknownGroup),
+			record.GID,
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	stateLabel := nagios.StateWARNINGLabel
+	exitCode := nagios.StateWARNINGExitCode
+	if orphaned.Critical {
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %d items owned by unknown user or group found in path %q",
+		stateLabel,
+		len(orphanedFound),
+		path,
+	)
+	nes.ExitStatusCode = exitCode
+
+	return orphanedErr
+
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/rs/zerolog"
+)
+
+func TestResolvePermittedIDsNumeric(t *testing.T) {
+	t.Parallel()
+
+	resolveIDs := config.ResolveIDs{
+		IDs: config.IDs{
+			Usernames:   []string{"1001", "0"},
+			GroupNames:  []string{"33"},
+			OwnerGroups: []string{"27", "4"},
+		},
+	}
+
+	want := permittedIDs{
+		UIDs:      []int{1001, 0},
+		GIDs:      []int{33},
+		OwnerGIDs: []int{27, 4},
+	}
+
+	logger := zerolog.Nop()
+
+	got, err := resolvePermittedIDs(resolveIDs, &logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v; want %+v", got, want)
+	}
+}
+
+func TestIDName(t *testing.T) {
+	t.Parallel()
+
+	if got := idName("deploy", false); got != "deploy" {
+		t.Errorf("idName() = %q; want %q", got, "deploy")
+	}
+
+	if got := idName("", true); got != "unknown" {
+		t.Errorf("idName() = %q; want %q", got, "unknown")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// linkedRecord pairs a MetaRecord with its hard link count.
+type linkedRecord struct {
+	paths.MetaRecord
+	links uint64
+}
+
+// checkMaxLinks is a helper variadic function that accepts one or many
+// MetaRecord values for hard link count evaluation. If any file (directories
+// are skipped) has more hard links than the specified threshold values, the
+// provided *nagios.Plugin is updated with the offending files and an error
+// is returned to signal that this specific check has found unexpected hard
+// links.
+func checkMaxLinks(path string, ths config.LinkCountThresholds, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var tooMany []linkedRecord
+	for _, record := range mrs {
+
+		// the link count of a directory reflects the number of
+		// subdirectories it contains
+		if record.IsDir() {
+			continue
+		}
+
+		links, ok := record.Links()
+		if !ok {
+			continue
+		}
+
+		if links > uint64(ths.Warning) {
+			tooMany = append(tooMany, linkedRecord{MetaRecord: record, links: links})
+		}
+	}
+
+	if len(tooMany) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(tooMany, func(i, j int) bool {
+		return tooMany[i].links > tooMany[j].links
+	})
+
+	most := tooMany[0]
+
+	var stateLabel string
+	var exitCode int
+	var threshold int
+	var numFailed int
+
+	switch {
+	case most.links > uint64(ths.Critical):
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+		threshold = ths.Critical
+
+		for _, record := range tooMany {
+			if record.links > uint64(ths.Critical) {
+				numFailed++
+			}
+		}
+
+	default:
+		stateLabel = nagios.StateWARNINGLabel
+		exitCode = nagios.StateWARNINGExitCode
+		threshold = ths.Warning
+		numFailed = len(tooMany)
+	}
+
+	tooManyLinksErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		numFailed,
+		len(mrs),
+		paths.ErrFileTooManyLinks,
+	)
+
+	zlog.Error().Err(tooManyLinksErr).
+		Int("critical_max_links", ths.Critical).
+		Int("warning_max_links", ths.Warning).
+		Uint64("most_links", most.links).
+		Str("most_linked_file", most.FQPath).
+		Str("path", path).
+		Msg(tooManyLinksErr.Error())
+
+	nes.AddError(tooManyLinksErr)
+
+	for i, record := range tooMany {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Hard links: %d additional files omitted%s",
+				len(tooMany)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Hard links %s** path: %q%s** links: %d%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			record.links,
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %d files with more than %d hard links found; most is %q (%d links) [path: %q]",
+		stateLabel,
+		numFailed,
+		threshold,
+		most.FQPath,
+		most.links,
+		path,
+	)
+
+	nes.ExitStatusCode = exitCode
+
+	return tooManyLinksErr
+
+}
@@ -67,6 +67,60 @@ func main() {
 	// group name to compare against files in specified path
 	resolveIDs := cfg.ResolveIDs()
 
+	// Resolve uid and gid values using alternate passwd and group files
+	// (e.g., those of a mounted container filesystem) if specified.
+	if cfg.PasswdFile() != "" || cfg.GroupFile() != "" {
+		if idFilesErr := paths.LoadIDFiles(cfg.PasswdFile(), cfg.GroupFile()); idFilesErr != nil {
+			plugin.AddError(idFilesErr)
+			plugin.ExitStatusCode = nagios.StateUNKNOWNExitCode
+			cfg.Log.Error().Err(idFilesErr).Msg("failed to load passwd or group file")
+
+			plugin.ServiceOutput = fmt.Sprintf(
+				"%s: Failed to load passwd or group file: %v",
+				nagios.StateUNKNOWNLabel,
+				idFilesErr,
+			)
+
+			return
+		}
+	}
+
+	// Resolve permitted usernames and group names to numeric IDs once up
+	// front so that ownership comparisons do not require a name lookup for
+	// each evaluated file.
+	var permitted permittedIDs
+	if resolveIDs.Any() {
+		var permittedErr error
+		permitted, permittedErr = resolvePermittedIDs(resolveIDs, &cfg.Log)
+		if permittedErr != nil {
+			plugin.AddError(permittedErr)
+			plugin.ExitStatusCode = nagios.StateUNKNOWNExitCode
+			cfg.Log.Error().Err(permittedErr).Msg("failed to resolve permitted usernames or group names")
+
+			plugin.ServiceOutput = fmt.Sprintf(
+				"%s: Failed to resolve permitted usernames or group names: %v",
+				nagios.StateUNKNOWNLabel,
+				permittedErr,
+			)
+
+			return
+		}
+	}
+
+	// Record uid and gid lookup cache statistics once all checks are
+	// complete.
+	if resolveIDs.Any() || cfg.OrphanedOwners().Check {
+		defer func() {
+			stats := paths.CacheStats()
+			cfg.Log.Debug().
+				Int("uid_cache_hits", stats.UserHits).
+				Int("uid_cache_misses", stats.UserMisses).
+				Int("gid_cache_hits", stats.GroupHits).
+				Int("gid_cache_misses", stats.GroupMisses).
+				Msg("uid/gid lookup cache statistics")
+		}()
+	}
+
 	// Flesh out plugin with some additional common details now that
 	// configuration flags have been parsed.
 	plugin.LongServiceOutput = fmt.Sprintf(
@@ -103,6 +157,99 @@ func main() {
 		return
 	}
 
+	// Evaluate the mount hosting each specified path before evaluating the
+	// content of those paths; content found within an unmounted directory
+	// is not the content we are interested in.
+	if mountCheck := cfg.Mount(); mountCheck.Any() {
+		if mountErr := checkMounts(cfg.PathsInclude(), mountCheck, &cfg.Log, plugin); mountErr != nil {
+			return
+		}
+	}
+
+	// Evaluate the target of each specified path before evaluating the
+	// content of those paths.
+	if symlinkTargetCheck := cfg.SymlinkTarget(); symlinkTargetCheck.Any() {
+		if symlinkErr := checkSymlinkTargets(cfg.PathsInclude(), symlinkTargetCheck, &cfg.Log, plugin); symlinkErr != nil {
+			return
+		}
+	}
+
+	// Evaluate each distinct filesystem hosting the specified paths once
+	// before evaluating the content of those paths.
+	if fsCheck := cfg.Filesystem(); fsCheck.Any() {
+		if fsErr := checkFilesystems(cfg.PathsInclude(), fsCheck, &cfg.Log, plugin); fsErr != nil {
+			return
+		}
+	}
+
+	pathFilter, filterErr := paths.NewPathFilter(
+		cfg.IncludePatterns(),
+		cfg.ExcludePatterns(),
+		cfg.PatternRegex(),
+	)
+	if filterErr != nil {
+		cfg.Log.Error().Err(filterErr).Msg("failed to prepare include/exclude patterns")
+
+		plugin.AddError(filterErr)
+		plugin.ServiceOutput = fmt.Sprintf(
+			"%s: Failed to prepare include/exclude patterns",
+			nagios.StateUNKNOWNLabel,
+		)
+		plugin.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+		return
+	}
+
+	xattrsCheck := cfg.Xattrs()
+	xattrMatcher, xattrErr := paths.NewXattrMatcher(
+		xattrsCheck.Required,
+		xattrsCheck.Forbidden,
+	)
+	if xattrErr != nil {
+		cfg.Log.Error().Err(xattrErr).Msg("failed to prepare extended attribute patterns")
+
+		plugin.AddError(xattrErr)
+		plugin.ServiceOutput = fmt.Sprintf(
+			"%s: Failed to prepare extended attribute patterns",
+			nagios.StateUNKNOWNLabel,
+		)
+		plugin.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+		return
+	}
+
+	// Mount entries are only needed to determine the type of filesystems
+	// encountered while walking the specified paths.
+	var mounts []paths.MountInfo
+	if len(cfg.SkipFSTypes()) > 0 {
+		var mountsErr error
+		mounts, mountsErr = paths.Mounts()
+		if mountsErr != nil {
+			cfg.Log.Error().Err(mountsErr).Msg("failed to retrieve mount entries")
+
+			plugin.AddError(mountsErr)
+			plugin.ServiceOutput = fmt.Sprintf(
+				"%s: Failed to retrieve mount entries",
+				nagios.StateUNKNOWNLabel,
+			)
+			plugin.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+			return
+		}
+	}
+
+	processOptions := paths.ProcessOptions{
+		IgnoreList:     cfg.PathsExclude(),
+		Recurse:        cfg.Recursive(),
+		MaxDepth:       cfg.MaxDepth(),
+		MinDepth:       cfg.MinDepth(),
+		Filter:         pathFilter,
+		FollowSymlinks: cfg.FollowSymlinks(),
+		OneFileSystem:  cfg.OneFileSystem(),
+		SkipFSTypes:    cfg.SkipFSTypes(),
+		Mounts:         mounts,
+	}
+
 	for _, path := range cfg.PathsInclude() {
 
 		cfg.Log.Debug().Msgf("Processing path %s ...", path)
@@ -116,12 +263,23 @@ func main() {
 		// Process continues walking the path until complete, one of the
 		// returned paths.MetaRecord values fails evaluation, or an error
 		// occurs, whichever comes first.
-		go paths.Process(ctx, path, cfg.PathsExclude(), cfg.Recursive(), results)
+		go paths.Process(ctx, path, processOptions, results)
 
 		// Collection of "records processed thus far" for the current path out
 		// of the specified list that we're evaluating.
 		var metaRecords paths.MetaRecords
 
+		// Accumulated size of records processed thus far for the current
+		// path. This is updated as each record is received so that the
+		// fail-fast size checks do not re-evaluate all prior records.
+		sizeTally := paths.NewSizeTally(cfg.SizeSource())
+
+		// Whether the current path is missing and the sysadmin opted to
+		// consider that OK. The newest file age, file count and emptiness
+		// checks are skipped for missing paths as a missing path has no
+		// content.
+		var pathMissingOK bool
+
 		for result := range results {
 
 			// fail early on errors from goroutine
@@ -132,6 +290,7 @@ func main() {
 				case errors.Is(result.Error, paths.ErrPathDoesNotExist):
 					if cfg.MissingOK() {
 						missingOKPaths = append(missingOKPaths, result.MetaRecord.FQPath)
+						pathMissingOK = true
 						continue
 					}
 
@@ -158,17 +317,26 @@ func main() {
 
 			// no error thus far
 			metaRecords = append(metaRecords, result.MetaRecord)
+			sizeTally.Add(result.MetaRecord)
 
 			if cfg.FailFast() {
 
 				ageCheck := cfg.Age()
 				if ageCheck.Set {
-					ageCheckErr := checkAge(path, ageCheck, &cfg.Log, plugin, result.MetaRecord)
+					ageCheckErr := checkAge(path, ageCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, result.MetaRecord)
 					if ageCheckErr != nil {
 						return
 					}
 				}
 
+				ageMinCheck := cfg.AgeMin()
+				if ageMinCheck.Set {
+					ageMinCheckErr := checkAgeMin(path, ageMinCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, result.MetaRecord)
+					if ageMinCheckErr != nil {
+						return
+					}
+				}
+
 				sizeMaxCheck := cfg.SizeMax()
 				sizeMinCheck := cfg.SizeMin()
 				if sizeMaxCheck.Set || sizeMinCheck.Set {
@@ -177,40 +345,189 @@ func main() {
 						SizeMax: sizeMaxCheck,
 					}
 
-					// evaluate the entire set of MetaRecord values each time
-					// (instead of one at a time) in order to fail-fast when
-					// the accumulated content size first crosses specified
-					// size thresholds.
-					sizeCheckErr := checkSize(path, thsMinMax, &cfg.Log, plugin, metaRecords...)
+					// evaluate the accumulated size of all MetaRecord values
+					// each time (instead of one at a time) in order to
+					// fail-fast when the accumulated content size first
+					// crosses specified size thresholds.
+					sizeCheckErr := checkSize(path, thsMinMax, sizeTally, &cfg.Log, plugin)
 					if sizeCheckErr != nil {
 						return
 					}
 
 				}
 
+				// Only the maximum file count can be evaluated before the
+				// path has been completely processed. The minimum file count
+				// is evaluated once all content has been collected.
+				countMaxCheck := cfg.CountMax()
+				if countMaxCheck.Set {
+					thsMinMax := config.FileCountThresholdsMinMax{
+						CountMax: countMaxCheck,
+					}
+
+					// evaluate the entire set of MetaRecord values each time
+					// in order to fail-fast when the accumulated file count
+					// first crosses specified thresholds.
+					countCheckErr := checkCount(path, thsMinMax, &cfg.Log, plugin, metaRecords...)
+					if countCheckErr != nil {
+						return
+					}
+				}
+
+				fileSizeMaxCheck := cfg.FileSizeMax()
+				if fileSizeMaxCheck.Set {
+					fileSizeErr := checkFileSize(path, fileSizeMaxCheck, cfg.SizeSource(), &cfg.Log, plugin, result.MetaRecord)
+					if fileSizeErr != nil {
+						return
+					}
+				}
+
+				maxLinksCheck := cfg.MaxLinks()
+				if maxLinksCheck.Set {
+					maxLinksErr := checkMaxLinks(path, maxLinksCheck, &cfg.Log, plugin, result.MetaRecord)
+					if maxLinksErr != nil {
+						return
+					}
+				}
+
 				// if this is set, then sysadmin requested that we assert that
 				// provided username or group name is present on all items
 				// (including directories) in the specified paths.
-				if resolveIDs.GroupNameCheck || resolveIDs.UsernameCheck {
-					idsErr := checkIDs(path, resolveIDs, &cfg.Log, plugin, result.MetaRecord)
+				if resolveIDs.Any() {
+					idsErr := checkIDs(path, resolveIDs, permitted, &cfg.Log, plugin, result.MetaRecord)
 					if idsErr != nil {
 						return
 					}
 				}
 
+				permsCheck := cfg.Permissions()
+				if permsCheck.Set {
+					permsErr := checkPermissions(path, permsCheck, &cfg.Log, plugin, result.MetaRecord)
+					if permsErr != nil {
+						return
+					}
+				}
+
+				modeMaxCheck := cfg.ModeMax()
+				if modeMaxCheck.Files.Set || modeMaxCheck.Directories.Set {
+					modeErr := checkModeMax(path, modeMaxCheck, &cfg.Log, plugin, result.MetaRecord)
+					if modeErr != nil {
+						return
+					}
+				}
+
+				securityAudit := cfg.SecurityAudit()
+				if securityAudit.SetIDCheck || securityAudit.WorldWritableDirsCheck {
+					auditErr := checkSecurityAudit(path, securityAudit, &cfg.Log, plugin, result.MetaRecord)
+					if auditErr != nil {
+						return
+					}
+				}
+
+				fileTypesCheck := cfg.FileTypes()
+				if fileTypesCheck.Any() {
+					fileTypesErr := checkFileTypes(path, fileTypesCheck, &cfg.Log, plugin, result.MetaRecord)
+					if fileTypesErr != nil {
+						return
+					}
+				}
+
+				orphanedCheck := cfg.OrphanedOwners()
+				if orphanedCheck.Check {
+					orphanedErr := checkOrphanedOwners(path, orphanedCheck, &cfg.Log, plugin, result.MetaRecord)
+					if orphanedErr != nil {
+						return
+					}
+				}
+
+				danglingCheck := cfg.DanglingSymlinks()
+				if danglingCheck.Check {
+					danglingErr := checkDanglingSymlinks(path, danglingCheck, &cfg.Log, plugin, result.MetaRecord)
+					if danglingErr != nil {
+						return
+					}
+				}
+
+				if xattrsCheck.Any() {
+					xattrsErr := checkXattrs(path, xattrsCheck, xattrMatcher, &cfg.Log, plugin, result.MetaRecord)
+					if xattrsErr != nil {
+						return
+					}
+				}
+
+				// Only the assertion that a directory is empty can be
+				// evaluated before the path has been completely processed.
+				// The assertion that a directory is not empty is evaluated
+				// once all content has been collected, as is the assertion
+				// that a directory is empty if recursion is disabled (the
+				// directory content is read separately).
+				emptinessCheck := cfg.Emptiness()
+				if emptinessCheck.EmptyCheck && processOptions.Recurse {
+					emptinessErr := checkEmptiness(path, emptinessCheck, processOptions, &cfg.Log, plugin, result.MetaRecord)
+					if emptinessErr != nil {
+						return
+					}
+				}
+
 			}
 
 		}
 
+		if cfg.FailFast() {
+			ageNewestCheck := cfg.AgeNewest()
+			if ageNewestCheck.Set && !pathMissingOK {
+				ageNewestErr := checkAgeNewest(path, ageNewestCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, metaRecords...)
+				if ageNewestErr != nil {
+					return
+				}
+			}
+
+			countMinCheck := cfg.CountMin()
+			if countMinCheck.Set && !pathMissingOK {
+				thsMinMax := config.FileCountThresholdsMinMax{
+					CountMin: countMinCheck,
+				}
+
+				countCheckErr := checkCount(path, thsMinMax, &cfg.Log, plugin, metaRecords...)
+				if countCheckErr != nil {
+					return
+				}
+			}
+
+			emptinessCheck := cfg.Emptiness()
+			if (emptinessCheck.NotEmptyCheck || (emptinessCheck.EmptyCheck && !processOptions.Recurse)) && !pathMissingOK {
+				emptinessErr := checkEmptiness(path, emptinessCheck, processOptions, &cfg.Log, plugin, metaRecords...)
+				if emptinessErr != nil {
+					return
+				}
+			}
+		}
+
 		if !cfg.FailFast() {
 			ageCheck := cfg.Age()
 			if ageCheck.Set {
-				ageCheckErr := checkAge(path, ageCheck, &cfg.Log, plugin, metaRecords...)
+				ageCheckErr := checkAge(path, ageCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, metaRecords...)
 				if ageCheckErr != nil {
 					return
 				}
 			}
 
+			ageMinCheck := cfg.AgeMin()
+			if ageMinCheck.Set {
+				ageMinCheckErr := checkAgeMin(path, ageMinCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, metaRecords...)
+				if ageMinCheckErr != nil {
+					return
+				}
+			}
+
+			ageNewestCheck := cfg.AgeNewest()
+			if ageNewestCheck.Set && !pathMissingOK {
+				ageNewestErr := checkAgeNewest(path, ageNewestCheck, cfg.AgeTimestamp(), &cfg.Log, plugin, metaRecords...)
+				if ageNewestErr != nil {
+					return
+				}
+			}
+
 			sizeMaxCheck := cfg.SizeMax()
 			sizeMinCheck := cfg.SizeMin()
 			if sizeMaxCheck.Set || sizeMinCheck.Set {
@@ -219,19 +536,112 @@ func main() {
 					SizeMax: sizeMaxCheck,
 				}
 
-				sizeCheckErr := checkSize(path, thsMinMax, &cfg.Log, plugin, metaRecords...)
+				sizeCheckErr := checkSize(path, thsMinMax, sizeTally, &cfg.Log, plugin)
 				if sizeCheckErr != nil {
 					return
 				}
 
 			}
 
-			if resolveIDs.GroupNameCheck || resolveIDs.UsernameCheck {
-				idsErr := checkIDs(path, resolveIDs, &cfg.Log, plugin, metaRecords...)
+			countMaxCheck := cfg.CountMax()
+			countMinCheck := cfg.CountMin()
+			if (countMaxCheck.Set || countMinCheck.Set) && !pathMissingOK {
+				thsMinMax := config.FileCountThresholdsMinMax{
+					CountMin: countMinCheck,
+					CountMax: countMaxCheck,
+				}
+
+				countCheckErr := checkCount(path, thsMinMax, &cfg.Log, plugin, metaRecords...)
+				if countCheckErr != nil {
+					return
+				}
+			}
+
+			fileSizeMaxCheck := cfg.FileSizeMax()
+			if fileSizeMaxCheck.Set {
+				fileSizeErr := checkFileSize(path, fileSizeMaxCheck, cfg.SizeSource(), &cfg.Log, plugin, metaRecords...)
+				if fileSizeErr != nil {
+					return
+				}
+			}
+
+			maxLinksCheck := cfg.MaxLinks()
+			if maxLinksCheck.Set {
+				maxLinksErr := checkMaxLinks(path, maxLinksCheck, &cfg.Log, plugin, metaRecords...)
+				if maxLinksErr != nil {
+					return
+				}
+			}
+
+			if resolveIDs.Any() {
+				idsErr := checkIDs(path, resolveIDs, permitted, &cfg.Log, plugin, metaRecords...)
 				if idsErr != nil {
 					return
 				}
 			}
+
+			permsCheck := cfg.Permissions()
+			if permsCheck.Set {
+				permsErr := checkPermissions(path, permsCheck, &cfg.Log, plugin, metaRecords...)
+				if permsErr != nil {
+					return
+				}
+			}
+
+			modeMaxCheck := cfg.ModeMax()
+			if modeMaxCheck.Files.Set || modeMaxCheck.Directories.Set {
+				modeErr := checkModeMax(path, modeMaxCheck, &cfg.Log, plugin, metaRecords...)
+				if modeErr != nil {
+					return
+				}
+			}
+
+			securityAudit := cfg.SecurityAudit()
+			if securityAudit.SetIDCheck || securityAudit.WorldWritableDirsCheck {
+				auditErr := checkSecurityAudit(path, securityAudit, &cfg.Log, plugin, metaRecords...)
+				if auditErr != nil {
+					return
+				}
+			}
+
+			fileTypesCheck := cfg.FileTypes()
+			if fileTypesCheck.Any() {
+				fileTypesErr := checkFileTypes(path, fileTypesCheck, &cfg.Log, plugin, metaRecords...)
+				if fileTypesErr != nil {
+					return
+				}
+			}
+
+			orphanedCheck := cfg.OrphanedOwners()
+			if orphanedCheck.Check {
+				orphanedErr := checkOrphanedOwners(path, orphanedCheck, &cfg.Log, plugin, metaRecords...)
+				if orphanedErr != nil {
+					return
+				}
+			}
+
+			danglingCheck := cfg.DanglingSymlinks()
+			if danglingCheck.Check {
+				danglingErr := checkDanglingSymlinks(path, danglingCheck, &cfg.Log, plugin, metaRecords...)
+				if danglingErr != nil {
+					return
+				}
+			}
+
+			if xattrsCheck.Any() {
+				xattrsErr := checkXattrs(path, xattrsCheck, xattrMatcher, &cfg.Log, plugin, metaRecords...)
+				if xattrsErr != nil {
+					return
+				}
+			}
+
+			emptinessCheck := cfg.Emptiness()
+			if (emptinessCheck.EmptyCheck || emptinessCheck.NotEmptyCheck) && !pathMissingOK {
+				emptinessErr := checkEmptiness(path, emptinessCheck, processOptions, &cfg.Log, plugin, metaRecords...)
+				if emptinessErr != nil {
+					return
+				}
+			}
 		}
 
 	}
@@ -245,15 +655,73 @@ func main() {
 	if cfg.SizeMax().Set {
 		otherChecksApplied = append(otherChecksApplied, "max size")
 	}
+	if cfg.FileSizeMax().Set {
+		otherChecksApplied = append(otherChecksApplied, "per-file max size")
+	}
+	if cfg.MaxLinks().Set {
+		otherChecksApplied = append(otherChecksApplied, "max links")
+	}
+	if cfg.CountMin().Set {
+		otherChecksApplied = append(otherChecksApplied, "min count")
+	}
+	if cfg.CountMax().Set {
+		otherChecksApplied = append(otherChecksApplied, "max count")
+	}
 	if cfg.Age().Set {
 		otherChecksApplied = append(otherChecksApplied, "age")
 	}
+	if cfg.AgeMin().Set {
+		otherChecksApplied = append(otherChecksApplied, "min age")
+	}
+	if cfg.AgeNewest().Set {
+		otherChecksApplied = append(otherChecksApplied, "newest file age")
+	}
 	if resolveIDs.UsernameCheck {
 		otherChecksApplied = append(otherChecksApplied, "username")
 	}
 	if resolveIDs.GroupNameCheck {
 		otherChecksApplied = append(otherChecksApplied, "group name")
 	}
+	if resolveIDs.OwnerGroupCheck {
+		otherChecksApplied = append(otherChecksApplied, "owner group membership")
+	}
+	if cfg.OrphanedOwners().Check {
+		otherChecksApplied = append(otherChecksApplied, "orphaned owner")
+	}
+	if cfg.Permissions().Set {
+		otherChecksApplied = append(otherChecksApplied, "permissions")
+	}
+	if cfg.ModeMax().Files.Set || cfg.ModeMax().Directories.Set {
+		otherChecksApplied = append(otherChecksApplied, "max mode")
+	}
+	if cfg.SecurityAudit().SetIDCheck || cfg.SecurityAudit().WorldWritableDirsCheck {
+		otherChecksApplied = append(otherChecksApplied, "security audit")
+	}
+	if cfg.FileTypes().Any() {
+		otherChecksApplied = append(otherChecksApplied, "file type")
+	}
+	if cfg.DanglingSymlinks().Check {
+		otherChecksApplied = append(otherChecksApplied, "dangling symlinks")
+	}
+	if cfg.Xattrs().Any() {
+		otherChecksApplied = append(otherChecksApplied, "extended attributes")
+	}
+	if cfg.SymlinkTarget().Any() {
+		otherChecksApplied = append(otherChecksApplied, "symlink target")
+	}
+	if cfg.Emptiness().EmptyCheck {
+		otherChecksApplied = append(otherChecksApplied, "empty directory")
+	}
+	if cfg.Emptiness().NotEmptyCheck {
+		otherChecksApplied = append(otherChecksApplied, "non-empty directory")
+	}
+
+	if cfg.Filesystem().Any() {
+		otherChecksApplied = append(otherChecksApplied, "filesystem free space")
+	}
+	if cfg.Mount().Any() {
+		otherChecksApplied = append(otherChecksApplied, "mount")
+	}
 
 	skippedEval := len(missingOKPaths)
 	ignoredEval := len(ignoredPaths)
@@ -0,0 +1,139 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkModeMax is a helper variadic function that accepts one or many
+// MetaRecord values for maximum permitted mode evaluation. If any evaluated
+// file or directory has a mode which grants bits outside of the specified
+// masks, the provided *nagios.Plugin is updated and an error is returned to
+// signal that this specific check has found overly permissive content.
+func checkModeMax(path string, ths config.FileModeThresholdsFileDir, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	// masks returns the applicable thresholds for the given record, if any.
+	masks := func(record paths.MetaRecord) (config.FileModeThresholds, bool) {
+		switch {
+		// modes for symlinks are not used by the OS and are reported as
+		// fully permissive, so we skip evaluating them
+		case record.Mode()&os.ModeSymlink != 0:
+			return config.FileModeThresholds{}, false
+		case record.IsDir():
+			return ths.Directories, ths.Directories.Set
+		default:
+			return ths.Files, ths.Files.Set
+		}
+	}
+
+	// Evaluate all CRITICAL masks before any WARNING masks so that the most
+	// severe state is reported when not using fail-fast behavior.
+	states := []struct {
+		allowed    func(config.FileModeThresholds) uint32
+		stateLabel string
+		exitCode   int
+	}{
+		{
+			allowed:    func(ths config.FileModeThresholds) uint32 { return ths.Critical },
+			stateLabel: nagios.StateCRITICALLabel,
+			exitCode:   nagios.StateCRITICALExitCode,
+		},
+		{
+			allowed:    func(ths config.FileModeThresholds) uint32 { return ths.Warning },
+			stateLabel: nagios.StateWARNINGLabel,
+			exitCode:   nagios.StateWARNINGExitCode,
+		},
+	}
+
+	for _, state := range states {
+
+		var numFailed int
+		for _, record := range mrs {
+
+			recordThs, ok := masks(record)
+			if !ok {
+				continue
+			}
+
+			allowedMode := state.allowed(recordThs)
+			actualMode := record.UnixMode()
+
+			if actualMode&^allowedMode == 0 {
+				continue
+			}
+
+			numFailed++
+
+			if numFailed > maxListedPaths {
+				continue
+			}
+
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Mode %s** path: %q%s** type: %s%s** mode: %04o%s** allowed: %04o%s",
+				nagios.CheckOutputEOL,
+				record.FQPath,
+				nagios.CheckOutputEOL,
+				recordThs.Description,
+				nagios.CheckOutputEOL,
+				actualMode,
+				nagios.CheckOutputEOL,
+				allowedMode,
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		if numFailed == 0 {
+			continue
+		}
+
+		if numFailed > maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Mode: %d additional items omitted%s",
+				numFailed-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		modeErr := fmt.Errorf(
+			"%d of %d items evaluated: %w",
+			numFailed,
+			len(mrs),
+			paths.ErrPathModeTooPermissive,
+		)
+
+		zlog.Error().Err(modeErr).
+			Bool("file_mode_max_check_enabled", ths.Files.Set).
+			Bool("dir_mode_max_check_enabled", ths.Directories.Set).
+			Int("items_failed", numFailed).
+			Str("path", path).
+			Msg("overly permissive modes found")
+
+		nes.AddError(modeErr)
+
+		nes.ServiceOutput = fmt.Sprintf(
+			"%s: %d items with mode more permissive than allowed found [path: %q]",
+			state.stateLabel,
+			numFailed,
+			path,
+		)
+
+		nes.ExitStatusCode = state.exitCode
+
+		return paths.ErrPathModeTooPermissive
+	}
+
+	return nil
+
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+func TestCheckModeMax(t *testing.T) {
+	t.Parallel()
+
+	filesOnly := config.FileModeThresholdsFileDir{
+		Files: config.FileModeThresholds{
+			Description: "file",
+			Critical:    0o755,
+			Warning:     0o644,
+			Set:         true,
+		},
+	}
+
+	filesAndDirs := filesOnly
+	filesAndDirs.Directories = config.FileModeThresholds{
+		Description: "directory",
+		Critical:    0o775,
+		Warning:     0o755,
+		Set:         true,
+	}
+
+	tests := []struct {
+		name     string
+		ths      config.FileModeThresholdsFileDir
+		records  []paths.MetaRecord
+		wantCode int
+		wantErr  error
+	}{
+		{
+			name:     "file within masks",
+			ths:      filesOnly,
+			records:  []paths.MetaRecord{testRecord("/srv/app/config", 0o640)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "file exceeding warning mask",
+			ths:      filesOnly,
+			records:  []paths.MetaRecord{testRecord("/srv/app/run.sh", 0o755)},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathModeTooPermissive,
+		},
+		{
+			name:     "file exceeding critical mask",
+			ths:      filesOnly,
+			records:  []paths.MetaRecord{testRecord("/srv/app/run.sh", 0o775)},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathModeTooPermissive,
+		},
+		{
+			name:     "setuid bit outside of mask",
+			ths:      filesOnly,
+			records:  []paths.MetaRecord{testRecord("/srv/app/run", os.ModeSetuid|0o755)},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathModeTooPermissive,
+		},
+		{
+			name:     "directory without directory masks",
+			ths:      filesOnly,
+			records:  []paths.MetaRecord{testRecord("/srv/app/data", os.ModeDir|0o777)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "directory evaluated against directory masks",
+			ths:      filesAndDirs,
+			records:  []paths.MetaRecord{testRecord("/srv/app/data", os.ModeDir|0o775)},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathModeTooPermissive,
+		},
+		{
+			name:     "sticky bit outside of directory mask",
+			ths:      filesAndDirs,
+			records:  []paths.MetaRecord{testRecord("/srv/app/tmp", os.ModeDir|os.ModeSticky|0o755)},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathModeTooPermissive,
+		},
+		{
+			name:     "symlinks are not evaluated",
+			ths:      filesAndDirs,
+			records:  []paths.MetaRecord{testRecord("/srv/app/current", os.ModeSymlink|0o777)},
+			wantCode: nagios.StateOKExitCode,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := nagios.NewPlugin()
+			logger := zerolog.Nop()
+
+			err := checkModeMax("/srv/app", tt.ths, &logger, plugin, tt.records...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if plugin.ExitStatusCode != tt.wantCode {
+				t.Errorf("got exit code %d; want %d", plugin.ExitStatusCode, tt.wantCode)
+			}
+		})
+	}
+}
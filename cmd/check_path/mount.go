@@ -0,0 +1,164 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkMounts is a helper function that evaluates the mount hosting each
+// specified path against the requested mount point, filesystem type and
+// mount option assertions. Mount details are recorded for every evaluated
+// path. If any assertion fails, the provided *nagios.Plugin is updated with
+// a CRITICAL state and an error is returned to signal that this specific
+// check has found a path which is not mounted as expected.
+//
+// Missing paths are skipped; these are handled by the evaluation of each
+// specified path.
+func checkMounts(pathsList []string, reqs config.MountRequirements, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	mounts, err := paths.Mounts()
+	if err != nil {
+		return filesystemCheckFailed(err, zlog, nes)
+	}
+
+	var mountErrs []error
+	var firstOutput string
+
+	for _, path := range pathsList {
+		mount, err := paths.MountForPath(path, mounts)
+		switch {
+		case errors.Is(err, paths.ErrPathDoesNotExist):
+			continue
+		case err != nil:
+			return filesystemCheckFailed(err, zlog, nes)
+		}
+
+		mountOptions := strings.Join(mount.MountOptions, ",")
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Mount for %q%s** device: %q%s** mount point: %q%s** type: %q%s** options: %q%s",
+			path,
+			nagios.CheckOutputEOL,
+			mount.Source,
+			nagios.CheckOutputEOL,
+			mount.MountPoint,
+			nagios.CheckOutputEOL,
+			mount.FSType,
+			nagios.CheckOutputEOL,
+			mountOptions,
+			nagios.CheckOutputEOL,
+		)
+
+		var problems []string
+
+		if reqs.MountPoint {
+			isMountPoint, err := paths.IsMountPoint(path, mounts)
+			if err != nil {
+				return filesystemCheckFailed(err, zlog, nes)
+			}
+
+			if !isMountPoint {
+				mountErrs = append(mountErrs, fmt.Errorf(
+					"%w: %s is hosted by %s",
+					paths.ErrPathNotMountPoint,
+					path,
+					mount.MountPoint,
+				))
+				problems = append(
+					problems,
+					fmt.Sprintf("not a mount point (hosted by %q)", mount.MountPoint),
+				)
+			}
+		}
+
+		if len(reqs.FSTypes) > 0 && !textutils.InList(mount.FSType, reqs.FSTypes) {
+			mountErrs = append(mountErrs, fmt.Errorf(
+				"%w: %s is hosted by %s filesystem; expected one of %v",
+				paths.ErrFilesystemType,
+				path,
+				mount.FSType,
+				reqs.FSTypes,
+			))
+			problems = append(
+				problems,
+				fmt.Sprintf("filesystem type %q not in %v", mount.FSType, reqs.FSTypes),
+			)
+		}
+
+		var missingOptions []string
+		for _, option := range reqs.MountOptions {
+			if !mount.HasOption(option) {
+				missingOptions = append(missingOptions, option)
+			}
+		}
+
+		if len(missingOptions) > 0 {
+			mountErrs = append(mountErrs, fmt.Errorf(
+				"%w: %s is hosted by %s mounted without %v",
+				paths.ErrMountOptionMissing,
+				path,
+				mount.MountPoint,
+				missingOptions,
+			))
+			problems = append(
+				problems,
+				fmt.Sprintf("missing mount options %v", missingOptions),
+			)
+		}
+
+		if len(problems) == 0 {
+			continue
+		}
+
+		zlog.Error().
+			Str("path", path).
+			Str("mount_point", mount.MountPoint).
+			Str("fs_type", mount.FSType).
+			Str("mount_options", mountOptions).
+			Strs("problems", problems).
+			Msg("mount requirements not met")
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"** problems: %s%s",
+			strings.Join(problems, "; "),
+			nagios.CheckOutputEOL,
+		)
+
+		if firstOutput == "" {
+			firstOutput = fmt.Sprintf(
+				"%s: mount requirements not met for %q: %s",
+				nagios.StateCRITICALLabel,
+				path,
+				strings.Join(problems, "; "),
+			)
+		}
+	}
+
+	if len(mountErrs) == 0 {
+		return nil
+	}
+
+	for _, mountErr := range mountErrs {
+		nes.AddError(mountErr)
+	}
+
+	nes.ServiceOutput = firstOutput
+	nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+	return mountErrs[0]
+
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/phayes/permbits"
+	"github.com/rs/zerolog"
+)
+
+// checkPermissions is a helper variadic function that accepts one or many
+// MetaRecord values for permission bit evaluation. If any of the specified
+// permission bit assertions fail, the provided *nagios.Plugin is updated and
+// an error is returned to signal that this specific check has found content
+// with unexpected permissions.
+func checkPermissions(path string, ths config.PermissionsThresholds, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	// Evaluate all CRITICAL assertions before any WARNING assertions so that
+	// the most severe state is reported when not using fail-fast behavior.
+	states := []struct {
+		assertions config.PermissionBits
+		stateLabel string
+		exitCode   int
+	}{
+		{
+			assertions: ths.Critical,
+			stateLabel: nagios.StateCRITICALLabel,
+			exitCode:   nagios.StateCRITICALExitCode,
+		},
+		{
+			assertions: ths.Warning,
+			stateLabel: nagios.StateWARNINGLabel,
+			exitCode:   nagios.StateWARNINGExitCode,
+		},
+	}
+
+	for _, state := range states {
+
+		if !state.assertions.Any() {
+			continue
+		}
+
+		var numFailed int
+		for _, record := range mrs {
+
+			// permissions for symlinks are not used by the OS and are
+			// reported as fully permissive, so we skip evaluating them
+			if record.Mode()&os.ModeSymlink != 0 {
+				continue
+			}
+
+			violations := permissionViolations(state.assertions, record.Permissions)
+			if len(violations) == 0 {
+				continue
+			}
+
+			numFailed++
+
+			if numFailed > maxListedPaths {
+				continue
+			}
+
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Permissions %s** path: %q%s** mode: %v%s** problems: %s%s",
+				nagios.CheckOutputEOL,
+				record.FQPath,
+				nagios.CheckOutputEOL,
+				record.Mode(),
+				nagios.CheckOutputEOL,
+				strings.Join(violations, ", "),
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		if numFailed == 0 {
+			continue
+		}
+
+		if numFailed > maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Permissions: %d additional items omitted%s",
+				numFailed-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		permsErr := fmt.Errorf(
+			"%d of %d items evaluated: %w",
+			numFailed,
+			len(mrs),
+			paths.ErrPathPermissions,
+		)
+
+		zlog.Error().Err(permsErr).
+			Str("assertions", state.assertions.String()).
+			Int("items_failed", numFailed).
+			Str("path", path).
+			Msg("unexpected permissions found")
+
+		nes.AddError(permsErr)
+
+		nes.ServiceOutput = fmt.Sprintf(
+			"%s: %d items with unexpected permissions found (%s) [path: %q]",
+			state.stateLabel,
+			numFailed,
+			state.assertions,
+			path,
+		)
+
+		nes.ExitStatusCode = state.exitCode
+
+		return paths.ErrPathPermissions
+	}
+
+	return nil
+
+}
+
+// permissionViolations evaluates the specified permission bit assertions
+// against the given permission bits and returns a description of each
+// assertion which fails. An empty list is returned if all assertions pass.
+func permissionViolations(assertions config.PermissionBits, perms permbits.PermissionBits) []string {
+
+	checks := []struct {
+		enabled bool
+		failed  bool
+		problem string
+	}{
+		{assertions.RequireGroupRead, !perms.GroupRead(), "group-read missing"},
+		{assertions.RequireGroupWrite, !perms.GroupWrite(), "group-write missing"},
+		{assertions.RequireGroupExecute, !perms.GroupExecute(), "group-execute missing"},
+		{assertions.ForbidGroupRead, perms.GroupRead(), "group-read present"},
+		{assertions.ForbidGroupWrite, perms.GroupWrite(), "group-write present"},
+		{assertions.ForbidGroupExecute, perms.GroupExecute(), "group-execute present"},
+		{assertions.ForbidOtherRead, perms.OtherRead(), "other-read present"},
+		{assertions.ForbidOtherWrite, perms.OtherWrite(), "other-write present"},
+		{assertions.ForbidOtherExecute, perms.OtherExecute(), "other-execute present"},
+	}
+
+	violations := make([]string, 0, len(checks))
+	for _, check := range checks {
+		if check.enabled && check.failed {
+			violations = append(violations, check.problem)
+		}
+	}
+
+	return violations
+
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/phayes/permbits"
+	"github.com/rs/zerolog"
+)
+
+// testFileInfo is an os.FileInfo implementation used to evaluate checks
+// against arbitrary file modes without creating files.
+type testFileInfo struct {
+	name string
+	mode os.FileMode
+	sys  any
+}
+
+func (fi testFileInfo) Name() string       { return fi.name }
+func (fi testFileInfo) Size() int64        { return 0 }
+func (fi testFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi testFileInfo) ModTime() time.Time { return time.Time{} }
+func (fi testFileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi testFileInfo) Sys() any           { return fi.sys }
+
+// testRecord returns a MetaRecord for the given path and file mode.
+func testRecord(path string, mode os.FileMode) paths.MetaRecord {
+	return paths.MetaRecord{
+		FileInfo:    testFileInfo{name: path, mode: mode},
+		Permissions: permbits.FileMode(mode),
+		FQPath:      path,
+	}
+}
+
+func TestPermissionViolations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		assertions config.PermissionBits
+		mode       os.FileMode
+		want       []string
+	}{
+		{
+			name:       "no assertions",
+			assertions: config.PermissionBits{},
+			mode:       0o777,
+			want:       []string{},
+		},
+		{
+			name:       "required bits present",
+			assertions: config.PermissionBits{RequireGroupRead: true, RequireGroupWrite: true},
+			mode:       0o660,
+			want:       []string{},
+		},
+		{
+			name: "required bits missing",
+			assertions: config.PermissionBits{
+				RequireGroupRead:    true,
+				RequireGroupWrite:   true,
+				RequireGroupExecute: true,
+			},
+			mode: 0o640,
+			want: []string{"group-write missing", "group-execute missing"},
+		},
+		{
+			name: "forbidden bits present",
+			assertions: config.PermissionBits{
+				ForbidGroupWrite:   true,
+				ForbidOtherRead:    true,
+				ForbidOtherWrite:   true,
+				ForbidOtherExecute: true,
+			},
+			mode: 0o675,
+			want: []string{"group-write present", "other-read present", "other-execute present"},
+		},
+		{
+			name:       "forbidden bits absent",
+			assertions: config.PermissionBits{ForbidGroupRead: true, ForbidGroupExecute: true},
+			mode:       0o700,
+			want:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := permissionViolations(tt.assertions, permbits.FileMode(tt.mode))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	t.Parallel()
+
+	ths := config.PermissionsThresholds{
+		Critical: config.PermissionBits{ForbidOtherWrite: true},
+		Warning:  config.PermissionBits{ForbidOtherRead: true},
+		Set:      true,
+	}
+
+	tests := []struct {
+		name     string
+		records  []paths.MetaRecord
+		wantCode int
+		wantErr  error
+	}{
+		{
+			name:     "no violations",
+			records:  []paths.MetaRecord{testRecord("/srv/app/config", 0o640)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "symlinks are not evaluated",
+			records:  []paths.MetaRecord{testRecord("/srv/app/current", os.ModeSymlink|0o777)},
+			wantCode: nagios.StateOKExitCode,
+		},
+		{
+			name:     "warning violation",
+			records:  []paths.MetaRecord{testRecord("/srv/app/config", 0o644)},
+			wantCode: nagios.StateWARNINGExitCode,
+			wantErr:  paths.ErrPathPermissions,
+		},
+		{
+			name: "critical takes precedence over warning",
+			records: []paths.MetaRecord{
+				testRecord("/srv/app/config", 0o644),
+				testRecord("/srv/app/data", 0o642),
+			},
+			wantCode: nagios.StateCRITICALExitCode,
+			wantErr:  paths.ErrPathPermissions,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := nagios.NewPlugin()
+			logger := zerolog.Nop()
+
+			err := checkPermissions("/srv/app", ths, &logger, plugin, tt.records...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if plugin.ExitStatusCode != tt.wantCode {
+				t.Errorf("got exit code %d; want %d", plugin.ExitStatusCode, tt.wantCode)
+			}
+		})
+	}
+}
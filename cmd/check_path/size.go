@@ -12,29 +12,25 @@ import (
 
 	"github.com/atc0005/check-path/internal/config"
 	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
 	"github.com/atc0005/go-nagios"
 	"github.com/rs/zerolog"
 )
 
-// checkSize is a helper variadic function that accepts one or many MetaRecord
-// values for size evaluation. If the specified size threshold values are
-// crossed, the provided *nagios.Plugin is updated and an error is returned
-// to signal that this specific check has found files which exceed specified
-// size values (either too small or too large).
-func checkSize(path string, ths config.FileSizeThresholdsMinMax, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
-
-	// type conversion to expose desired methods
-	metaRecords := paths.MetaRecords(mrs)
-
-	// sort if more than one entry
-	if len(mrs) > 1 {
-		metaRecords.SortBySizeAsc()
-	}
+// checkSize is a helper function that accepts the accumulated size of
+// MetaRecord values for size evaluation. If the specified size threshold
+// values are crossed, the provided *nagios.Plugin is updated and an error is
+// returned to signal that this specific check has found files which exceed
+// specified size values (either too small or too large). The size source
+// used by the provided *paths.SizeTally determines whether apparent or
+// allocated file sizes are used.
+func checkSize(path string, ths config.FileSizeThresholdsMinMax, tally *paths.SizeTally, zlog *zerolog.Logger, nes *nagios.Plugin) error {
 
 	// NOTE: Directories (themselves) are not included in the size values,
-	// just the contents of said directories.
-	actualSizeHR := metaRecords.TotalFileSizeHR()
-	actualSizeBytes := metaRecords.TotalFileSize()
+	// just the contents of said directories. Hard-linked files are only
+	// counted once.
+	actualSizeBytes := tally.Total()
+	actualSizeHR := units.ByteCountIEC(actualSizeBytes)
 
 	// warning threshold required, so we can use that to reduce
 	// conditional check logic complexity
@@ -44,13 +40,13 @@ func checkSize(path string, ths config.FileSizeThresholdsMinMax, zlog *zerolog.L
 		sizeOfFilesTooLargeErr := fmt.Errorf(
 			"%w (%d evaluated)",
 			paths.ErrSizeOfFilesTooLarge,
-			len(metaRecords),
+			tally.Len(),
 		)
 
 		sizeOfFilesTooSmallErr := fmt.Errorf(
 			"%w (%d evaluated)",
 			paths.ErrSizeOfFilesTooSmall,
-			len(metaRecords),
+			tally.Len(),
 		)
 
 		serviceOutputTmpl := fmt.Sprintf(
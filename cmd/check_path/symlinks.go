@@ -0,0 +1,217 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// checkDanglingSymlinks is a helper variadic function that accepts one or
+// many MetaRecord values for evaluation of symlinks whose targets do not
+// exist. If any are found, the provided *nagios.Plugin is updated and an
+// error is returned to signal that this specific check has found dangling
+// symlinks.
+func checkDanglingSymlinks(path string, dangling config.DanglingSymlinks, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var danglingFound []paths.MetaRecord
+
+	for _, record := range mrs {
+		if record.Dangling {
+			danglingFound = append(danglingFound, record)
+		}
+	}
+
+	if len(danglingFound) == 0 {
+		return nil
+	}
+
+	danglingErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		len(danglingFound),
+		len(mrs),
+		paths.ErrDanglingSymlink,
+	)
+
+	zlog.Error().Err(danglingErr).
+		Bool("dangling_symlinks_check_enabled", dangling.Check).
+		Str("path", path).
+		Msg("dangling symlinks found")
+
+	nes.AddError(danglingErr)
+
+	for i, record := range danglingFound {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Symlink: %d additional items omitted%s",
+				len(danglingFound)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Symlink %s** path: %q%s** target: %q%s",
+			nagios.CheckOutputEOL,
+			record.FQPath,
+			nagios.CheckOutputEOL,
+			record.LinkTarget,
+			nagios.CheckOutputEOL,
+		)
+	}
+
+	stateLabel := nagios.StateWARNINGLabel
+	exitCode := nagios.StateWARNINGExitCode
+	if dangling.Critical {
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %d dangling symlinks found in path %q",
+		stateLabel,
+		len(danglingFound),
+		path,
+	)
+	nes.ExitStatusCode = exitCode
+
+	return danglingErr
+
+}
+
+// checkSymlinkTargets is a helper function that evaluates whether each
+// specified path is a symlink whose target matches the expected target and,
+// if requested, whether the target is the newest entry in its parent
+// directory. Entries are ordered by name with runs of digits compared
+// numerically (version order) unless another ordering (e.g., by modification
+// time) is requested. Either the target recorded in the symlink or the fully
+// resolved target may match the expected target. If any assertion fails, the
+// provided *nagios.Plugin is updated with a CRITICAL state and an error is
+// returned to signal that this specific check has found an unexpected
+// symlink target.
+//
+// Missing paths are skipped; these are handled by the evaluation of each
+// specified path.
+func checkSymlinkTargets(pathsList []string, sta config.SymlinkTargetAssertions, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	var targetMatcher *regexp.Regexp
+	if sta.Target != "" {
+		var err error
+		targetMatcher, err = textutils.GlobToRegexp(sta.Target)
+		if err != nil {
+			return symlinkTargetFailed(sta.Target, err, zlog, nes)
+		}
+	}
+
+	for _, path := range pathsList {
+		targets, err := paths.SymlinkTarget(path)
+		switch {
+		case errors.Is(err, paths.ErrPathDoesNotExist):
+			continue
+
+		case errors.Is(err, paths.ErrPathNotSymlink),
+			errors.Is(err, paths.ErrDanglingSymlink):
+			return symlinkTargetFailed(path, err, zlog, nes)
+
+		case err != nil:
+			zlog.Error().Err(err).Str("path", path).Msg("failed to resolve symlink target")
+
+			nes.AddError(err)
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: failed to resolve symlink target for %q",
+				nagios.StateUNKNOWNLabel,
+				path,
+			)
+			nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+			return err
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Symlink %s** path: %q%s** target: %q%s** resolved target: %q%s",
+			nagios.CheckOutputEOL,
+			path,
+			nagios.CheckOutputEOL,
+			targets.Direct,
+			nagios.CheckOutputEOL,
+			targets.Resolved,
+			nagios.CheckOutputEOL,
+		)
+
+		if targetMatcher != nil &&
+			!targetMatcher.MatchString(filepath.ToSlash(targets.Direct)) &&
+			!targetMatcher.MatchString(filepath.ToSlash(targets.Resolved)) {
+
+			return symlinkTargetFailed(
+				path,
+				fmt.Errorf(
+					"%w: %s -> %s; expected %s",
+					paths.ErrSymlinkTarget,
+					path,
+					targets.Resolved,
+					sta.Target,
+				),
+				zlog,
+				nes,
+			)
+		}
+
+		if sta.Newest {
+			newest, err := paths.NewestSibling(targets.Resolved, sta.Order)
+			if err != nil {
+				return symlinkTargetFailed(path, err, zlog, nes)
+			}
+
+			if newest != targets.Resolved {
+				return symlinkTargetFailed(
+					path,
+					fmt.Errorf(
+						"%w: %s -> %s; newest entry (order: %s) is %s",
+						paths.ErrSymlinkTargetNotNewest,
+						path,
+						targets.Resolved,
+						sta.Order,
+						newest,
+					),
+					zlog,
+					nes,
+				)
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// symlinkTargetFailed is a helper function used to record a CRITICAL state
+// if a specified path is not a symlink with the expected target.
+func symlinkTargetFailed(path string, err error, zlog *zerolog.Logger, nes *nagios.Plugin) error {
+
+	zlog.Error().Err(err).Str("path", path).Msg("symlink target assertion failed")
+
+	nes.AddError(err)
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: symlink target assertion failed for %q: %v",
+		nagios.StateCRITICALLabel,
+		path,
+		err,
+	)
+	nes.ExitStatusCode = nagios.StateCRITICALExitCode
+
+	return err
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
 	"github.com/atc0005/check-path/internal/units"
 	"github.com/atc0005/go-nagios"
 )
@@ -30,10 +31,17 @@ func setThresholdDescriptions(cfg *config.Config, nes *nagios.Plugin) {
 		nes.WarningThreshold = "[Paths exist]"
 	}
 
+	// note the timestamp source for age checks if not the default
+	var ageSource string
+	if cfg.AgeTimestamp() != paths.TimestampModified {
+		ageSource = fmt.Sprintf(" (%s)", cfg.AgeTimestamp())
+	}
+
 	if age := cfg.Age(); age.Set {
 		ageCriticalThreshold := fmt.Sprintf(
-			"[File age in days: %d]",
-			age.Critical,
+			"[File age%s: %s]",
+			ageSource,
+			units.FormatDuration(age.Critical),
 		)
 
 		switch {
@@ -50,8 +58,9 @@ func setThresholdDescriptions(cfg *config.Config, nes *nagios.Plugin) {
 		}
 
 		ageWarningThreshold := fmt.Sprintf(
-			"[File age in days: %d]",
-			age.Warning,
+			"[File age%s: %s]",
+			ageSource,
+			units.FormatDuration(age.Warning),
 		)
 
 		switch {
@@ -69,6 +78,30 @@ func setThresholdDescriptions(cfg *config.Config, nes *nagios.Plugin) {
 
 	}
 
+	if ageMin := cfg.AgeMin(); ageMin.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Min file age%s: %s]", ageSource, units.FormatDuration(ageMin.Critical)),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Min file age%s: %s]", ageSource, units.FormatDuration(ageMin.Warning)),
+		)
+	}
+
+	if ageNewest := cfg.AgeNewest(); ageNewest.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Newest file age%s: %s]", ageSource, units.FormatDuration(ageNewest.Critical)),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Newest file age%s: %s]", ageSource, units.FormatDuration(ageNewest.Warning)),
+		)
+	}
+
 	if sizeMin := cfg.SizeMin(); sizeMin.Set {
 
 		sizeMinCriticalThreshold := fmt.Sprintf(
@@ -153,4 +186,299 @@ func setThresholdDescriptions(cfg *config.Config, nes *nagios.Plugin) {
 
 	}
 
+	if fileSizeMax := cfg.FileSizeMax(); fileSizeMax.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf(
+				"[Max per-file size (bytes: %d, Human: %s)]",
+				fileSizeMax.Critical,
+				units.ByteCountIEC(fileSizeMax.Critical),
+			),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf(
+				"[Max per-file size (bytes: %d, Human: %s)]",
+				fileSizeMax.Warning,
+				units.ByteCountIEC(fileSizeMax.Warning),
+			),
+		)
+	}
+
+	if maxLinks := cfg.MaxLinks(); maxLinks.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Max hard links: %d]", maxLinks.Critical),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Max hard links: %d]", maxLinks.Warning),
+		)
+	}
+
+	if countMin := cfg.CountMin(); countMin.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Min File count: %d]", countMin.Critical),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Min File count: %d]", countMin.Warning),
+		)
+	}
+
+	if countMax := cfg.CountMax(); countMax.Set {
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Max File count: %d]", countMax.Critical),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Max File count: %d]", countMax.Warning),
+		)
+	}
+
+	if perms := cfg.Permissions(); perms.Set {
+		if perms.Critical.Any() {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Permissions: %s]", perms.Critical),
+			)
+		}
+
+		if perms.Warning.Any() {
+			nes.WarningThreshold = joinThresholdDescriptions(
+				nes.WarningThreshold,
+				fmt.Sprintf("[Permissions: %s]", perms.Warning),
+			)
+		}
+	}
+
+	for _, modeMax := range []config.FileModeThresholds{cfg.ModeMax().Files, cfg.ModeMax().Directories} {
+		if !modeMax.Set {
+			continue
+		}
+
+		nes.CriticalThreshold = joinThresholdDescriptions(
+			nes.CriticalThreshold,
+			fmt.Sprintf("[Max %s mode: %04o]", modeMax.Description, modeMax.Critical),
+		)
+
+		nes.WarningThreshold = joinThresholdDescriptions(
+			nes.WarningThreshold,
+			fmt.Sprintf("[Max %s mode: %04o]", modeMax.Description, modeMax.Warning),
+		)
+	}
+
+	if audit := cfg.SecurityAudit(); audit.SetIDCheck || audit.WorldWritableDirsCheck {
+		const (
+			setIDDescription             string = "[Setuid/setgid executables found]"
+			worldWritableDirsDescription string = "[World-writable dirs without sticky bit found]"
+		)
+
+		switch {
+		case audit.SetIDCritical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, setIDDescription)
+		case audit.SetIDWarning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, setIDDescription)
+		}
+
+		switch {
+		case audit.WorldWritableDirsCritical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, worldWritableDirsDescription)
+		case audit.WorldWritableDirsWarning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, worldWritableDirsDescription)
+		}
+	}
+
+	if fileTypes := cfg.FileTypes(); fileTypes.Any() {
+		if fileTypes.PathCheck() {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Path type not in: %s]", strings.Join(fileTypes.PathTypes, ", ")),
+			)
+		}
+
+		var contentDescriptions []string
+		if len(fileTypes.Allowed) > 0 {
+			contentDescriptions = append(
+				contentDescriptions,
+				fmt.Sprintf("[File type not in: %s]", strings.Join(fileTypes.Allowed, ", ")),
+			)
+		}
+		if len(fileTypes.Denied) > 0 {
+			contentDescriptions = append(
+				contentDescriptions,
+				fmt.Sprintf("[File type in: %s]", strings.Join(fileTypes.Denied, ", ")),
+			)
+		}
+
+		for _, description := range contentDescriptions {
+			switch {
+			case fileTypes.Critical:
+				nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, description)
+			case fileTypes.Warning:
+				nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, description)
+			}
+		}
+	}
+
+	if orphaned := cfg.OrphanedOwners(); orphaned.Check {
+		const orphanedDescription string = "[Content owned by unknown user or group found]"
+
+		switch {
+		case orphaned.Critical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, orphanedDescription)
+		case orphaned.Warning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, orphanedDescription)
+		}
+	}
+
+	if xattrs := cfg.Xattrs(); xattrs.Any() {
+		var xattrDescriptions []string
+		if len(xattrs.Required) > 0 {
+			xattrDescriptions = append(
+				xattrDescriptions,
+				fmt.Sprintf("[Extended attributes missing: %s]", strings.Join(xattrs.Required, ", ")),
+			)
+		}
+		if len(xattrs.Forbidden) > 0 {
+			xattrDescriptions = append(
+				xattrDescriptions,
+				fmt.Sprintf("[Extended attributes present: %s]", strings.Join(xattrs.Forbidden, ", ")),
+			)
+		}
+
+		for _, description := range xattrDescriptions {
+			switch {
+			case xattrs.Critical:
+				nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, description)
+			case xattrs.Warning:
+				nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, description)
+			}
+		}
+	}
+
+	if dangling := cfg.DanglingSymlinks(); dangling.Check {
+		const danglingDescription string = "[Dangling symlinks found]"
+
+		switch {
+		case dangling.Critical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, danglingDescription)
+		case dangling.Warning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, danglingDescription)
+		}
+	}
+
+	if symlinkTarget := cfg.SymlinkTarget(); symlinkTarget.Any() {
+		if symlinkTarget.Target != "" {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Symlink target not matching: %s]", symlinkTarget.Target),
+			)
+		}
+
+		if symlinkTarget.Newest {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				"[Symlink target not newest entry]",
+			)
+		}
+	}
+
+	if emptiness := cfg.Emptiness(); emptiness.EmptyCheck || emptiness.NotEmptyCheck {
+		const (
+			emptyDescription    string = "[Directory not empty]"
+			notEmptyDescription string = "[Directory empty]"
+		)
+
+		switch {
+		case emptiness.EmptyCritical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, emptyDescription)
+		case emptiness.EmptyWarning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, emptyDescription)
+		case emptiness.NotEmptyCritical:
+			nes.CriticalThreshold = joinThresholdDescriptions(nes.CriticalThreshold, notEmptyDescription)
+		case emptiness.NotEmptyWarning:
+			nes.WarningThreshold = joinThresholdDescriptions(nes.WarningThreshold, notEmptyDescription)
+		}
+	}
+
+	if fs := cfg.Filesystem(); fs.Any() {
+		for _, th := range []struct {
+			ths    config.FilesystemFreeThresholds
+			format func(float64) string
+		}{
+			{
+				ths: fs.FreeBytes,
+				format: func(v float64) string {
+					return units.ByteCountIEC(int64(v))
+				},
+			},
+			{
+				ths: fs.FreePercent,
+				format: func(v float64) string {
+					return fmt.Sprintf("%v%%", v)
+				},
+			},
+			{
+				ths: fs.InodesFreePercent,
+				format: func(v float64) string {
+					return fmt.Sprintf("%v%%", v)
+				},
+			},
+		} {
+			if !th.ths.Set {
+				continue
+			}
+
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Min %s: %s]", th.ths.Description, th.format(th.ths.Critical)),
+			)
+
+			nes.WarningThreshold = joinThresholdDescriptions(
+				nes.WarningThreshold,
+				fmt.Sprintf("[Min %s: %s]", th.ths.Description, th.format(th.ths.Warning)),
+			)
+		}
+	}
+
+	if mount := cfg.Mount(); mount.Any() {
+		if mount.MountPoint {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				"[Path not a mount point]",
+			)
+		}
+
+		if len(mount.FSTypes) > 0 {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Filesystem type not in: %s]", strings.Join(mount.FSTypes, ", ")),
+			)
+		}
+
+		if len(mount.MountOptions) > 0 {
+			nes.CriticalThreshold = joinThresholdDescriptions(
+				nes.CriticalThreshold,
+				fmt.Sprintf("[Mount options missing: %s]", strings.Join(mount.MountOptions, ", ")),
+			)
+		}
+	}
+}
+
+// joinThresholdDescriptions is a helper function used to append a threshold
+// description to any existing threshold descriptions.
+func joinThresholdDescriptions(existing string, description string) string {
+	switch {
+	case existing != "":
+		return strings.Join([]string{existing, description}, ", ")
+	default:
+		return description
+	}
 }
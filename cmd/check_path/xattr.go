@@ -0,0 +1,139 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/config"
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/go-nagios"
+	"github.com/rs/zerolog"
+)
+
+// xattrMismatch records the extended attribute assertions failed by an
+// evaluated entry.
+type xattrMismatch struct {
+	record    paths.MetaRecord
+	missing   []string
+	forbidden []string
+}
+
+// checkXattrs is a helper variadic function that accepts one or many
+// MetaRecord values for evaluation of extended attributes (e.g., file
+// capabilities or POSIX ACLs) against the required and forbidden extended
+// attribute lists. Symlinks are not followed when reading extended
+// attributes. If any entry does not match, the provided *nagios.Plugin is
+// updated and an error is returned to signal that this specific check has
+// found unexpected extended attributes.
+func checkXattrs(path string, xattrs config.XattrAssertions, matcher paths.XattrMatcher, zlog *zerolog.Logger, nes *nagios.Plugin, mrs ...paths.MetaRecord) error {
+
+	var mismatches []xattrMismatch
+
+	for _, record := range mrs {
+		attrs, err := paths.Xattrs(record.FQPath)
+		if err != nil {
+			zlog.Error().Err(err).
+				Str("path", path).
+				Msg("failed to read extended attributes")
+
+			nes.AddError(err)
+			nes.ServiceOutput = fmt.Sprintf(
+				"%s: failed to read extended attributes: %v [path: %q]",
+				nagios.StateUNKNOWNLabel,
+				err,
+				record.FQPath,
+			)
+			nes.ExitStatusCode = nagios.StateUNKNOWNExitCode
+
+			return err
+		}
+
+		missing, forbidden := matcher.Evaluate(attrs)
+		if len(missing) > 0 || len(forbidden) > 0 {
+			mismatches = append(mismatches, xattrMismatch{
+				record:    record,
+				missing:   missing,
+				forbidden: forbidden,
+			})
+		}
+	}
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	xattrErr := fmt.Errorf(
+		"%d of %d items evaluated: %w",
+		len(mismatches),
+		len(mrs),
+		paths.ErrPathXattr,
+	)
+
+	zlog.Error().Err(xattrErr).
+		Strs("required_xattrs", xattrs.Required).
+		Strs("forbidden_xattrs", xattrs.Forbidden).
+		Str("path", path).
+		Msg("unexpected extended attributes found")
+
+	nes.AddError(xattrErr)
+
+	for i, mismatch := range mismatches {
+		if i >= maxListedPaths {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"* Extended attributes: %d additional items omitted%s",
+				len(mismatches)-maxListedPaths,
+				nagios.CheckOutputEOL,
+			)
+
+			break
+		}
+
+		nes.LongServiceOutput += fmt.Sprintf(
+			"* Extended attributes %s** path: %q%s",
+			nagios.CheckOutputEOL,
+			mismatch.record.FQPath,
+			nagios.CheckOutputEOL,
+		)
+
+		if len(mismatch.missing) > 0 {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"** missing: %s%s",
+				strings.Join(mismatch.missing, ", "),
+				nagios.CheckOutputEOL,
+			)
+		}
+
+		if len(mismatch.forbidden) > 0 {
+			nes.LongServiceOutput += fmt.Sprintf(
+				"** forbidden: %s%s",
+				strings.Join(mismatch.forbidden, ", "),
+				nagios.CheckOutputEOL,
+			)
+		}
+	}
+
+	stateLabel := nagios.StateWARNINGLabel
+	exitCode := nagios.StateWARNINGExitCode
+	if xattrs.Critical {
+		stateLabel = nagios.StateCRITICALLabel
+		exitCode = nagios.StateCRITICALExitCode
+	}
+
+	nes.ServiceOutput = fmt.Sprintf(
+		"%s: %d items with unexpected extended attributes found in path %q",
+		stateLabel,
+		len(mismatches),
+		path,
+	)
+	nes.ExitStatusCode = exitCode
+
+	return xattrErr
+
+}
@@ -14,11 +14,11 @@ require (
 	github.com/atc0005/go-nagios v0.20.0
 	github.com/phayes/permbits v0.0.0-20190612203442-39d7c581d2ee
 	github.com/rs/zerolog v1.34.0
+	golang.org/x/sys v0.33.0
 )
 
 require (
 	github.com/alexflint/go-scalar v1.2.0 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	golang.org/x/sys v0.33.0 // indirect
 )
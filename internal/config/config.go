@@ -43,31 +43,93 @@ func (c Config) String() string {
 	return fmt.Sprintf(
 		"{ PathsInclude: %v, "+
 			"PathsExclude: %v, "+
+			"IncludePatterns: %q, "+
+			"ExcludePatterns: %q, "+
+			"PatternRegex: %v, "+
 			"LogLevel: %v, "+
 			"Recursive: %v, "+
+			"MaxDepth: %v, "+
+			"MinDepth: %v, "+
+			"FollowSymlinks: %v, "+
+			"OneFileSystem: %v, "+
+			"SkipFSTypes: %v, "+
 			"MissingOK: %v, "+
 			"EmitBranding: %v, "+
 			"Age: [Critical: %v, Warning: %v, Set: %v], "+
+			"AgeMin: [Critical: %v, Warning: %v, Set: %v], "+
+			"AgeNewest: [Critical: %v, Warning: %v, Set: %v], "+
+			"AgeTimestamp: %v, "+
 			"SizeMin: [Critical: %v, Warning: %v, Set: %v], "+
 			"SizeMax: [Critical: %v, Warning: %v, Set: %v], "+
+			"FileSizeMax: [Critical: %v, Warning: %v, Set: %v], "+
+			"SizeSource: %v, "+
+			"MaxLinks: [Critical: %v, Warning: %v, Set: %v], "+
+			"CountMin: [Critical: %v, Warning: %v, Set: %v], "+
+			"CountMax: [Critical: %v, Warning: %v, Set: %v], "+
 			"PathExists: [Critical: %v, Warning: %v], "+
 			"User: [Name: %q, Critical: %v, Warning: %v], "+
-			"Group: [Name: %q, Critical: %v, Warning: %v] }",
+			"Group: [Name: %q, Critical: %v, Warning: %v], "+
+			"OrphanedOwner: [Critical: %v, Warning: %v], "+
+			"OwnerGroup: [Name: %q, Critical: %v, Warning: %v], "+
+			"IDFiles: [Passwd: %q, Group: %q], "+
+			"Permissions: [Critical: %q, Warning: %q], "+
+			"ModeMax: [Critical: %04o, Warning: %04o, Set: %v], "+
+			"DirModeMax: [Critical: %04o, Warning: %04o, Set: %v], "+
+			"SetID: [Critical: %v, Warning: %v, Allowed: %v], "+
+			"WorldWritableDirs: [Critical: %v, Warning: %v], "+
+			"AssertEmpty: [Critical: %v, Warning: %v], "+
+			"AssertNotEmpty: [Critical: %v, Warning: %v], "+
+			"FileTypes: [Path: %v, Allowed: %v, Denied: %v, Critical: %v, Warning: %v], "+
+			"DanglingSymlinks: [Critical: %v, Warning: %v], "+
+			"SymlinkTarget: [Target: %q, Newest: %v, Order: %q], "+
+			"Xattrs: [Required: %q, Forbidden: %q, Critical: %v, Warning: %v], "+
+			"FSFreeBytes: [Critical: %v, Warning: %v, Set: %v], "+
+			"FSFreePercent: [Critical: %v, Warning: %v, Set: %v], "+
+			"FSInodesFreePercent: [Critical: %v, Warning: %v, Set: %v], "+
+			"Mount: [MountPoint: %v, FSTypes: %v, Options: %v] }",
 		c.PathsInclude(),
 		c.PathsExclude(),
+		c.IncludePatterns(),
+		c.ExcludePatterns(),
+		c.PatternRegex(),
 		c.LogLevel(),
 		c.Recursive(),
+		c.MaxDepth(),
+		c.MinDepth(),
+		c.FollowSymlinks(),
+		c.OneFileSystem(),
+		c.SkipFSTypes(),
 		c.MissingOK(),
 		c.EmitBranding(),
 		c.Age().Critical,
 		c.Age().Warning,
 		c.Age().Set,
+		c.AgeMin().Critical,
+		c.AgeMin().Warning,
+		c.AgeMin().Set,
+		c.AgeNewest().Critical,
+		c.AgeNewest().Warning,
+		c.AgeNewest().Set,
+		c.AgeTimestamp(),
 		c.SizeMin().Critical,
 		c.SizeMin().Warning,
 		c.SizeMin().Set,
 		c.SizeMax().Critical,
 		c.SizeMax().Warning,
 		c.SizeMax().Set,
+		c.FileSizeMax().Critical,
+		c.FileSizeMax().Warning,
+		c.FileSizeMax().Set,
+		c.SizeSource(),
+		c.MaxLinks().Critical,
+		c.MaxLinks().Warning,
+		c.MaxLinks().Set,
+		c.CountMin().Critical,
+		c.CountMin().Warning,
+		c.CountMin().Set,
+		c.CountMax().Critical,
+		c.CountMax().Warning,
+		c.CountMax().Set,
 		c.PathExistsCritical(),
 		c.PathExistsWarning(),
 		c.Username(),
@@ -76,6 +138,56 @@ func (c Config) String() string {
 		c.GroupName(),
 		c.GroupNameCritical(),
 		c.GroupNameWarning(),
+		c.OrphanedOwners().Critical,
+		c.OrphanedOwners().Warning,
+		c.OwnerGroup(),
+		c.OwnerGroupCritical(),
+		c.OwnerGroupWarning(),
+		c.PasswdFile(),
+		c.GroupFile(),
+		c.Permissions().Critical,
+		c.Permissions().Warning,
+		c.ModeMax().Files.Critical,
+		c.ModeMax().Files.Warning,
+		c.ModeMax().Files.Set,
+		c.ModeMax().Directories.Critical,
+		c.ModeMax().Directories.Warning,
+		c.ModeMax().Directories.Set,
+		c.SecurityAudit().SetIDCritical,
+		c.SecurityAudit().SetIDWarning,
+		c.SecurityAudit().SetIDAllowed,
+		c.SecurityAudit().WorldWritableDirsCritical,
+		c.SecurityAudit().WorldWritableDirsWarning,
+		c.Emptiness().EmptyCritical,
+		c.Emptiness().EmptyWarning,
+		c.Emptiness().NotEmptyCritical,
+		c.Emptiness().NotEmptyWarning,
+		c.FileTypes().PathTypes,
+		c.FileTypes().Allowed,
+		c.FileTypes().Denied,
+		c.FileTypes().Critical,
+		c.FileTypes().Warning,
+		c.DanglingSymlinks().Critical,
+		c.DanglingSymlinks().Warning,
+		c.SymlinkTarget().Target,
+		c.SymlinkTarget().Newest,
+		c.SymlinkTarget().Order,
+		c.Xattrs().Required,
+		c.Xattrs().Forbidden,
+		c.Xattrs().Critical,
+		c.Xattrs().Warning,
+		c.Filesystem().FreeBytes.Critical,
+		c.Filesystem().FreeBytes.Warning,
+		c.Filesystem().FreeBytes.Set,
+		c.Filesystem().FreePercent.Critical,
+		c.Filesystem().FreePercent.Warning,
+		c.Filesystem().FreePercent.Set,
+		c.Filesystem().InodesFreePercent.Critical,
+		c.Filesystem().InodesFreePercent.Warning,
+		c.Filesystem().InodesFreePercent.Set,
+		c.Mount().MountPoint,
+		c.Mount().FSTypes,
+		c.Mount().MountOptions,
 	)
 }
 
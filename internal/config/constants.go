@@ -7,6 +7,13 @@
 
 package config
 
+import (
+	"time"
+
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/units"
+)
+
 const (
 
 	// MyAppName is the public name of this application.
@@ -26,6 +33,11 @@ const (
 	defaultSearchRecursive bool   = false
 	defaultSearchMissingOK bool   = false
 	defaultSearchFailFast  bool   = false
+	defaultPatternRegex    bool   = false
+	defaultMaxDepth        int    = 0
+	defaultMinDepth        int    = 0
+	defaultFollowSymlinks  bool   = false
+	defaultOneFileSystem   bool   = false
 	defaultEmitBranding    bool   = false
 
 	// these values have to be supplied via flag by the sysadmin to be useful
@@ -33,8 +45,94 @@ const (
 	defaultGroupName string = ""
 )
 
-// used by SizeMin and SizeMax getter methods for threshold descriptions
+// used by Age, AgeMin and AgeNewest getter methods for threshold descriptions
+const (
+	ageMaxDescription    string = "maximum"
+	ageMinDescription    string = "minimum"
+	ageNewestDescription string = "newest file"
+)
+
+// Age values provided without a unit suffix retain their original meaning;
+// days for the maximum and minimum age checks and hours for the newest file
+// age check.
+const (
+	defaultAgeUnit       time.Duration = units.Day
+	defaultAgeNewestUnit time.Duration = time.Hour
+)
+
+const defaultAgeTimestamp string = paths.TimestampModified
+
+// Supported size sources for size checks. These are the size sources
+// supported by the paths package.
+const (
+
+	// SizeSourceApparent selects the apparent size (length) of files
+	SizeSourceApparent string = paths.SizeApparent
+
+	// SizeSourceAllocated selects the disk space allocated to files
+	SizeSourceAllocated string = paths.SizeAllocated
+)
+
+const defaultSizeSource string = paths.SizeApparent
+
+const defaultSymlinkTargetOrder string = paths.SiblingOrderVersion
+
+// Supported file types for file type assertions. These are the file types
+// supported by the paths package.
+const (
+
+	// FileTypeRegular matches regular files
+	FileTypeRegular string = paths.FileTypeRegular
+
+	// FileTypeDirectory matches directories
+	FileTypeDirectory string = paths.FileTypeDirectory
+
+	// FileTypeSymlink matches symbolic links
+	FileTypeSymlink string = paths.FileTypeSymlink
+
+	// FileTypeSocket matches Unix domain sockets
+	FileTypeSocket string = paths.FileTypeSocket
+
+	// FileTypeNamedPipe matches named pipes (FIFOs)
+	FileTypeNamedPipe string = paths.FileTypeNamedPipe
+
+	// FileTypeBlockDevice matches block device nodes
+	FileTypeBlockDevice string = paths.FileTypeBlockDevice
+
+	// FileTypeCharDevice matches character device nodes
+	FileTypeCharDevice string = paths.FileTypeCharDevice
+
+	// FileTypeIrregular matches files of an unknown type
+	FileTypeIrregular string = paths.FileTypeIrregular
+)
+
+// used by SizeMin, SizeMax and FileSizeMax getter methods for threshold
+// descriptions
 const (
-	sizeMinDescription string = "minimum"
-	sizeMaxDescription string = "maximum"
+	sizeMinDescription     string = "minimum"
+	sizeMaxDescription     string = "maximum"
+	fileSizeMaxDescription string = "per-file maximum"
 )
+
+// used by CountMin and CountMax getter methods for threshold descriptions
+const (
+	countMinDescription string = "minimum"
+	countMaxDescription string = "maximum"
+)
+
+// used by ModeMax getter method for threshold descriptions
+const (
+	modeMaxFilesDescription       string = "file"
+	modeMaxDirectoriesDescription string = "directory"
+)
+
+// used by the Filesystem getter method for threshold descriptions
+const (
+	fsFreeBytesDescription         string = "filesystem free space in bytes"
+	fsFreePercentDescription       string = "filesystem free space percentage"
+	fsInodesFreePercentDescription string = "filesystem free inodes percentage"
+)
+
+// maxModeMask is the largest supported mode mask, covering the setuid,
+// setgid and sticky bits along with the standard permission bits.
+const maxModeMask uint64 = 0o7777
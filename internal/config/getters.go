@@ -7,7 +7,12 @@
 
 package config
 
-import "path/filepath"
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/units"
+)
 
 // PathsInclude returns the user-provided list of paths to check or an empty
 // list if a user-specified list of paths was not provided. Each path in the
@@ -50,6 +55,40 @@ func (c Config) PathsExclude() []string {
 	}
 }
 
+// IncludePatterns returns the user-provided list of patterns used to select
+// content to evaluate or an empty list if not provided.
+func (c Config) IncludePatterns() []string {
+	switch {
+	case c.Search.IncludePatterns != nil:
+		return c.Search.IncludePatterns
+	default:
+		return []string{}
+	}
+}
+
+// ExcludePatterns returns the user-provided list of patterns used to skip
+// content or an empty list if not provided.
+func (c Config) ExcludePatterns() []string {
+	switch {
+	case c.Search.ExcludePatterns != nil:
+		return c.Search.ExcludePatterns
+	default:
+		return []string{}
+	}
+}
+
+// PatternRegex returns the user-provided choice of whether include and
+// exclude patterns are regular expressions or the default value if not
+// provided.
+func (c Config) PatternRegex() bool {
+	switch {
+	case c.Search.PatternRegex != nil:
+		return *c.Search.PatternRegex
+	default:
+		return defaultPatternRegex
+	}
+}
+
 // LogLevel returns the user-provided logging level or the default value if
 // not provided.
 func (c Config) LogLevel() string {
@@ -73,6 +112,64 @@ func (c Config) Recursive() bool {
 	}
 }
 
+// MaxDepth returns the user-provided maximum depth of evaluated content or
+// the default value (no limit) if not provided.
+func (c Config) MaxDepth() int {
+	switch {
+	case c.Search.MaxDepth != nil:
+		return *c.Search.MaxDepth
+	default:
+		return defaultMaxDepth
+	}
+}
+
+// MinDepth returns the user-provided minimum depth of evaluated content or
+// the default value if not provided.
+func (c Config) MinDepth() int {
+	switch {
+	case c.Search.MinDepth != nil:
+		return *c.Search.MinDepth
+	default:
+		return defaultMinDepth
+	}
+}
+
+// FollowSymlinks returns the user-provided choice of whether symlinks are
+// followed when evaluating specified paths or the default value if not
+// provided.
+func (c Config) FollowSymlinks() bool {
+	switch {
+	case c.Search.FollowSymlinks != nil:
+		return *c.Search.FollowSymlinks
+	default:
+		return defaultFollowSymlinks
+	}
+}
+
+// OneFileSystem returns the user-provided choice of whether content hosted by
+// a filesystem other than the one hosting each specified path is skipped or
+// the default value if not provided.
+func (c Config) OneFileSystem() bool {
+	switch {
+	case c.Search.OneFileSystem != nil:
+		return *c.Search.OneFileSystem
+	default:
+		return defaultOneFileSystem
+	}
+}
+
+// SkipFSTypes returns the user-specified list of filesystem types whose
+// content is skipped when performing a recursive search or an empty list if
+// not specified.
+func (c Config) SkipFSTypes() []string {
+	switch {
+	case c.Search.SkipFSTypes != nil:
+		return splitListValues(c.Search.SkipFSTypes)
+	default:
+		return []string{}
+	}
+}
+
 // MissingOK returns the user-provided choice of whether missing paths are
 // considered OK or the default value if not provided.
 func (c Config) MissingOK() bool {
@@ -108,23 +205,86 @@ func (c Config) EmitBranding() bool {
 	}
 }
 
-// Age returns the user-provided CRITICAL and WARNING thresholds in days for
-// the specified paths.
+// Age returns the user-provided CRITICAL and WARNING maximum age thresholds
+// for the specified paths.
 func (c Config) Age() FileAgeThresholds {
 	switch {
 	case c.Search.AgeCritical != nil && c.Search.AgeWarning != nil:
+		// values are validated separately; invalid values are zero here
+		critical, _ := units.ParseDuration(*c.Search.AgeCritical, defaultAgeUnit)
+		warning, _ := units.ParseDuration(*c.Search.AgeWarning, defaultAgeUnit)
+
 		return FileAgeThresholds{
-			Critical: *c.Search.AgeCritical,
-			Warning:  *c.Search.AgeWarning,
-			Set:      true,
+			Description: ageMaxDescription,
+			Critical:    critical,
+			Warning:     warning,
+			Set:         true,
 		}
 	default:
 		return FileAgeThresholds{
-			Set: false,
+			Description: ageMaxDescription,
+			Set:         false,
 		}
 	}
 }
 
+// AgeMin returns the user-provided CRITICAL and WARNING thresholds for the
+// minimum age of files in the specified paths.
+func (c Config) AgeMin() FileAgeThresholds {
+	switch {
+	case c.Search.AgeMinCritical != nil && c.Search.AgeMinWarning != nil:
+		// values are validated separately; invalid values are zero here
+		critical, _ := units.ParseDuration(*c.Search.AgeMinCritical, defaultAgeUnit)
+		warning, _ := units.ParseDuration(*c.Search.AgeMinWarning, defaultAgeUnit)
+
+		return FileAgeThresholds{
+			Description: ageMinDescription,
+			Critical:    critical,
+			Warning:     warning,
+			Set:         true,
+		}
+	default:
+		return FileAgeThresholds{
+			Description: ageMinDescription,
+			Set:         false,
+		}
+	}
+}
+
+// AgeNewest returns the user-provided CRITICAL and WARNING thresholds for
+// the age of the most recently modified file in the specified paths.
+func (c Config) AgeNewest() FileAgeThresholds {
+	switch {
+	case c.Search.AgeNewestCritical != nil && c.Search.AgeNewestWarning != nil:
+		// values are validated separately; invalid values are zero here
+		critical, _ := units.ParseDuration(*c.Search.AgeNewestCritical, defaultAgeNewestUnit)
+		warning, _ := units.ParseDuration(*c.Search.AgeNewestWarning, defaultAgeNewestUnit)
+
+		return FileAgeThresholds{
+			Description: ageNewestDescription,
+			Critical:    critical,
+			Warning:     warning,
+			Set:         true,
+		}
+	default:
+		return FileAgeThresholds{
+			Description: ageNewestDescription,
+			Set:         false,
+		}
+	}
+}
+
+// AgeTimestamp returns the user-provided timestamp source used to determine
+// file age or the default value if not provided.
+func (c Config) AgeTimestamp() string {
+	switch {
+	case c.Search.AgeTimestamp != nil:
+		return *c.Search.AgeTimestamp
+	default:
+		return defaultAgeTimestamp
+	}
+}
+
 // SizeMin returns the user-provided CRITICAL and WARNING thresholds for
 // minimum size in bytes for the specified paths.
 func (c Config) SizeMin() FileSizeThresholds {
@@ -163,6 +323,91 @@ func (c Config) SizeMax() FileSizeThresholds {
 	}
 }
 
+// FileSizeMax returns the user-provided CRITICAL and WARNING thresholds for
+// maximum size in bytes of any individual file in the specified paths.
+func (c Config) FileSizeMax() FileSizeThresholds {
+	switch {
+	case c.Search.FileSizeMaxCritical != nil && c.Search.FileSizeMaxWarning != nil:
+		return FileSizeThresholds{
+			Description: fileSizeMaxDescription,
+			Critical:    *c.Search.FileSizeMaxCritical,
+			Warning:     *c.Search.FileSizeMaxWarning,
+			Set:         true,
+		}
+	default:
+		return FileSizeThresholds{
+			Description: fileSizeMaxDescription,
+			Set:         false,
+		}
+	}
+}
+
+// SizeSource returns the user-provided size source used for size checks or
+// the default value if not provided.
+func (c Config) SizeSource() string {
+	switch {
+	case c.Search.SizeSource != nil:
+		return *c.Search.SizeSource
+	default:
+		return defaultSizeSource
+	}
+}
+
+// MaxLinks returns the user-provided CRITICAL and WARNING thresholds for
+// maximum number of hard links to each file in the specified paths.
+func (c Config) MaxLinks() LinkCountThresholds {
+	switch {
+	case c.Search.MaxLinksCritical != nil && c.Search.MaxLinksWarning != nil:
+		return LinkCountThresholds{
+			Critical: *c.Search.MaxLinksCritical,
+			Warning:  *c.Search.MaxLinksWarning,
+			Set:      true,
+		}
+	default:
+		return LinkCountThresholds{
+			Set: false,
+		}
+	}
+}
+
+// CountMin returns the user-provided CRITICAL and WARNING thresholds for
+// minimum number of files for the specified paths.
+func (c Config) CountMin() FileCountThresholds {
+	switch {
+	case c.Search.CountMinCritical != nil && c.Search.CountMinWarning != nil:
+		return FileCountThresholds{
+			Description: countMinDescription,
+			Critical:    *c.Search.CountMinCritical,
+			Warning:     *c.Search.CountMinWarning,
+			Set:         true,
+		}
+	default:
+		return FileCountThresholds{
+			Description: countMinDescription,
+			Set:         false,
+		}
+	}
+}
+
+// CountMax returns the user-provided CRITICAL and WARNING thresholds for
+// maximum number of files for the specified paths.
+func (c Config) CountMax() FileCountThresholds {
+	switch {
+	case c.Search.CountMaxCritical != nil && c.Search.CountMaxWarning != nil:
+		return FileCountThresholds{
+			Description: countMaxDescription,
+			Critical:    *c.Search.CountMaxCritical,
+			Warning:     *c.Search.CountMaxWarning,
+			Set:         true,
+		}
+	default:
+		return FileCountThresholds{
+			Description: countMaxDescription,
+			Set:         false,
+		}
+	}
+}
+
 // PathExistsCritical indicates whether the existence of specified paths is
 // considered a CRITICAL state.
 func (c Config) PathExistsCritical() bool {
@@ -201,6 +446,17 @@ func (c Config) UsernameWarning() bool {
 	return c.Search.UsernameMissingWarning != nil
 }
 
+// Usernames returns the list of permitted usernames or numeric user IDs
+// specified via the username-missing-critical or username-missing-warning
+// flags.
+func (c Config) Usernames() []string {
+	if c.Username() == "" {
+		return nil
+	}
+
+	return splitListValues([]string{c.Username()})
+}
+
 // GroupName returns the user-provided group name set via the
 // group-name-missing-critical or group-name-missing-warning flags or the
 // default value if not provided.
@@ -227,20 +483,390 @@ func (c Config) GroupNameWarning() bool {
 	return c.Search.GroupNameMissingWarning != nil
 }
 
+// GroupNames returns the list of permitted group names or numeric group IDs
+// specified via the group-name-missing-critical or
+// group-name-missing-warning flags.
+func (c Config) GroupNames() []string {
+	if c.GroupName() == "" {
+		return nil
+	}
+
+	return splitListValues([]string{c.GroupName()})
+}
+
+// OwnerGroup returns the user-provided group names or numeric group IDs set
+// via the owner-group-member-critical or owner-group-member-warning flags or
+// an empty string if not provided.
+func (c Config) OwnerGroup() string {
+	switch {
+	case c.Search.OwnerGroupMemberCritical != nil:
+		return *c.Search.OwnerGroupMemberCritical
+	case c.Search.OwnerGroupMemberWarning != nil:
+		return *c.Search.OwnerGroupMemberWarning
+	default:
+		return ""
+	}
+}
+
+// OwnerGroupCritical indicates whether user opted to check that content
+// owners are members of a group. Failing results indicate a CRITICAL state.
+func (c Config) OwnerGroupCritical() bool {
+	return c.Search.OwnerGroupMemberCritical != nil
+}
+
+// OwnerGroupWarning indicates whether user opted to check that content
+// owners are members of a group. Failing results indicate a WARNING state.
+func (c Config) OwnerGroupWarning() bool {
+	return c.Search.OwnerGroupMemberWarning != nil
+}
+
+// OwnerGroups returns the list of group names or numeric group IDs of which
+// content owners are required to be a member.
+func (c Config) OwnerGroups() []string {
+	if c.OwnerGroup() == "" {
+		return nil
+	}
+
+	return splitListValues([]string{c.OwnerGroup()})
+}
+
+// PasswdFile returns the user-provided path to an alternate passwd file used
+// to resolve user IDs or an empty string if not provided.
+func (c Config) PasswdFile() string {
+	if c.Search.PasswdFile == nil {
+		return ""
+	}
+
+	return *c.Search.PasswdFile
+}
+
+// GroupFile returns the user-provided path to an alternate group file used
+// to resolve group IDs or an empty string if not provided.
+func (c Config) GroupFile() string {
+	if c.Search.GroupFile == nil {
+		return ""
+	}
+
+	return *c.Search.GroupFile
+}
+
 // ResolveIDs returns a ResolveIDs type which indicates whether user opted to
 // resolve user and group id values to name values and if so, at which exit
 // state values.
 func (c Config) ResolveIDs() ResolveIDs {
 	return ResolveIDs{
 		IDs: IDs{
-			Username:  c.Username(),
-			GroupName: c.GroupName(),
+			Usernames:   c.Usernames(),
+			GroupNames:  c.GroupNames(),
+			OwnerGroups: c.OwnerGroups(),
 		},
-		UsernameCheck:     c.UsernameCritical() || c.UsernameWarning(),
-		UsernameCritical:  c.UsernameCritical(),
-		UsernameWarning:   c.UsernameWarning(),
-		GroupNameCheck:    c.GroupNameCritical() || c.GroupNameWarning(),
-		GroupNameCritical: c.GroupNameCritical(),
-		GroupNameWarning:  c.GroupNameWarning(),
+		UsernameCheck:      c.UsernameCritical() || c.UsernameWarning(),
+		UsernameCritical:   c.UsernameCritical(),
+		UsernameWarning:    c.UsernameWarning(),
+		GroupNameCheck:     c.GroupNameCritical() || c.GroupNameWarning(),
+		GroupNameCritical:  c.GroupNameCritical(),
+		GroupNameWarning:   c.GroupNameWarning(),
+		OwnerGroupCheck:    c.OwnerGroupCritical() || c.OwnerGroupWarning(),
+		OwnerGroupCritical: c.OwnerGroupCritical(),
+		OwnerGroupWarning:  c.OwnerGroupWarning(),
+	}
+}
+
+// OrphanedOwners returns an OrphanedOwners type which indicates whether user
+// opted to check specified paths for content owned by unknown user or group
+// IDs and if so, at which exit state values.
+func (c Config) OrphanedOwners() OrphanedOwners {
+	orphanedCritical := c.Search.OrphanedOwnerCritical != nil &&
+		*c.Search.OrphanedOwnerCritical
+	orphanedWarning := c.Search.OrphanedOwnerWarning != nil &&
+		*c.Search.OrphanedOwnerWarning
+
+	return OrphanedOwners{
+		Check:    orphanedCritical || orphanedWarning,
+		Critical: orphanedCritical,
+		Warning:  orphanedWarning,
+	}
+}
+
+// Permissions returns the user-provided permission bit assertions for the
+// specified paths. Each assertion is recorded for the exit state requested
+// by the sysadmin.
+func (c Config) Permissions() PermissionsThresholds {
+	isSet := func(b *bool) bool { return b != nil && *b }
+
+	critical := PermissionBits{
+		RequireGroupRead:    isSet(c.Search.RequireGroupReadCritical),
+		RequireGroupWrite:   isSet(c.Search.RequireGroupWriteCritical),
+		RequireGroupExecute: isSet(c.Search.RequireGroupExecuteCritical),
+		ForbidGroupRead:     isSet(c.Search.ForbidGroupReadCritical),
+		ForbidGroupWrite:    isSet(c.Search.ForbidGroupWriteCritical),
+		ForbidGroupExecute:  isSet(c.Search.ForbidGroupExecuteCritical),
+		ForbidOtherRead:     isSet(c.Search.ForbidOtherReadCritical),
+		ForbidOtherWrite:    isSet(c.Search.ForbidOtherWriteCritical),
+		ForbidOtherExecute:  isSet(c.Search.ForbidOtherExecuteCritical),
+	}
+
+	warning := PermissionBits{
+		RequireGroupRead:    isSet(c.Search.RequireGroupReadWarning),
+		RequireGroupWrite:   isSet(c.Search.RequireGroupWriteWarning),
+		RequireGroupExecute: isSet(c.Search.RequireGroupExecuteWarning),
+		ForbidGroupRead:     isSet(c.Search.ForbidGroupReadWarning),
+		ForbidGroupWrite:    isSet(c.Search.ForbidGroupWriteWarning),
+		ForbidGroupExecute:  isSet(c.Search.ForbidGroupExecuteWarning),
+		ForbidOtherRead:     isSet(c.Search.ForbidOtherReadWarning),
+		ForbidOtherWrite:    isSet(c.Search.ForbidOtherWriteWarning),
+		ForbidOtherExecute:  isSet(c.Search.ForbidOtherExecuteWarning),
+	}
+
+	return PermissionsThresholds{
+		Critical: critical,
+		Warning:  warning,
+		Set:      critical.Any() || warning.Any(),
+	}
+}
+
+// ModeMax returns the user-provided CRITICAL and WARNING maximum permitted
+// mode masks for files and directories in the specified paths.
+func (c Config) ModeMax() FileModeThresholdsFileDir {
+	modeMax := func(description string, critical *string, warning *string) FileModeThresholds {
+		switch {
+		case critical != nil && warning != nil:
+			// validation has already asserted that these values parse
+			criticalMask, _ := parseModeMask(*critical)
+			warningMask, _ := parseModeMask(*warning)
+
+			return FileModeThresholds{
+				Description: description,
+				Critical:    criticalMask,
+				Warning:     warningMask,
+				Set:         true,
+			}
+		default:
+			return FileModeThresholds{
+				Description: description,
+				Set:         false,
+			}
+		}
+	}
+
+	return FileModeThresholdsFileDir{
+		Files: modeMax(
+			modeMaxFilesDescription,
+			c.Search.ModeMaxCritical,
+			c.Search.ModeMaxWarning,
+		),
+		Directories: modeMax(
+			modeMaxDirectoriesDescription,
+			c.Search.DirModeMaxCritical,
+			c.Search.DirModeMaxWarning,
+		),
+	}
+}
+
+// SetIDAllowed returns the user-provided list of expected setuid or setgid
+// executables or an empty list if a user-specified list of paths was not
+// provided. Each path in the list is processed by filepath.Clean.
+func (c Config) SetIDAllowed() []string {
+	switch {
+	case c.Search.SetIDAllowed != nil:
+		cleanedPaths := make([]string, len(c.Search.SetIDAllowed))
+		for i, path := range c.Search.SetIDAllowed {
+			cleanedPaths[i] = filepath.Clean(path)
+		}
+
+		return cleanedPaths
+
+	default:
+		return []string{}
+	}
+}
+
+// SecurityAudit returns a SecurityAudit type which indicates whether user
+// opted to audit specified paths for setuid/setgid executables or
+// world-writable directories missing the sticky bit and if so, at which exit
+// state values.
+func (c Config) SecurityAudit() SecurityAudit {
+	setIDCritical := c.Search.SetIDCritical != nil && *c.Search.SetIDCritical
+	setIDWarning := c.Search.SetIDWarning != nil && *c.Search.SetIDWarning
+
+	worldWritableDirsCritical := c.Search.WorldWritableDirsCritical != nil &&
+		*c.Search.WorldWritableDirsCritical
+	worldWritableDirsWarning := c.Search.WorldWritableDirsWarning != nil &&
+		*c.Search.WorldWritableDirsWarning
+
+	return SecurityAudit{
+		SetIDCheck:                setIDCritical || setIDWarning,
+		SetIDCritical:             setIDCritical,
+		SetIDWarning:              setIDWarning,
+		SetIDAllowed:              c.SetIDAllowed(),
+		WorldWritableDirsCheck:    worldWritableDirsCritical || worldWritableDirsWarning,
+		WorldWritableDirsCritical: worldWritableDirsCritical,
+		WorldWritableDirsWarning:  worldWritableDirsWarning,
+	}
+}
+
+// Emptiness returns a DirectoryEmptiness type which indicates whether user
+// opted to assert that specified directories are empty or not empty and if
+// so, at which exit state values.
+func (c Config) Emptiness() DirectoryEmptiness {
+	emptyCritical := c.Search.AssertEmptyCritical != nil && *c.Search.AssertEmptyCritical
+	emptyWarning := c.Search.AssertEmptyWarning != nil && *c.Search.AssertEmptyWarning
+
+	notEmptyCritical := c.Search.AssertNotEmptyCritical != nil &&
+		*c.Search.AssertNotEmptyCritical
+	notEmptyWarning := c.Search.AssertNotEmptyWarning != nil &&
+		*c.Search.AssertNotEmptyWarning
+
+	return DirectoryEmptiness{
+		EmptyCheck:       emptyCritical || emptyWarning,
+		EmptyCritical:    emptyCritical,
+		EmptyWarning:     emptyWarning,
+		NotEmptyCheck:    notEmptyCritical || notEmptyWarning,
+		NotEmptyCritical: notEmptyCritical,
+		NotEmptyWarning:  notEmptyWarning,
+	}
+}
+
+// DanglingSymlinks returns a DanglingSymlinks type which indicates whether
+// user opted to check specified paths for symlinks whose targets do not exist
+// and if so, at which exit state values.
+func (c Config) DanglingSymlinks() DanglingSymlinks {
+	danglingCritical := c.Search.DanglingSymlinksCritical != nil &&
+		*c.Search.DanglingSymlinksCritical
+	danglingWarning := c.Search.DanglingSymlinksWarning != nil &&
+		*c.Search.DanglingSymlinksWarning
+
+	return DanglingSymlinks{
+		Check:    danglingCritical || danglingWarning,
+		Critical: danglingCritical,
+		Warning:  danglingWarning,
+	}
+}
+
+// SymlinkTarget returns the user-specified assertions for the target of
+// specified paths which are expected to be symlinks.
+func (c Config) SymlinkTarget() SymlinkTargetAssertions {
+	var sta SymlinkTargetAssertions
+
+	if c.Search.SymlinkTarget != nil {
+		sta.Target = *c.Search.SymlinkTarget
+	}
+
+	if c.Search.SymlinkTargetNewest != nil {
+		sta.Newest = *c.Search.SymlinkTargetNewest
+	}
+
+	switch {
+	case c.Search.SymlinkTargetOrder != nil:
+		sta.Order = *c.Search.SymlinkTargetOrder
+	default:
+		sta.Order = defaultSymlinkTargetOrder
+	}
+
+	return sta
+}
+
+// Filesystem returns the user-provided CRITICAL and WARNING thresholds for
+// free space and free inodes on the filesystems hosting specified paths.
+func (c Config) Filesystem() FilesystemThresholds {
+	ths := FilesystemThresholds{
+		FreeBytes: FilesystemFreeThresholds{
+			Description: fsFreeBytesDescription,
+		},
+		FreePercent: FilesystemFreeThresholds{
+			Description: fsFreePercentDescription,
+		},
+		InodesFreePercent: FilesystemFreeThresholds{
+			Description: fsInodesFreePercentDescription,
+		},
+	}
+
+	if c.Search.FSFreeBytesCritical != nil && c.Search.FSFreeBytesWarning != nil {
+		ths.FreeBytes.Critical = float64(*c.Search.FSFreeBytesCritical)
+		ths.FreeBytes.Warning = float64(*c.Search.FSFreeBytesWarning)
+		ths.FreeBytes.Set = true
+	}
+
+	if c.Search.FSFreePercentCritical != nil && c.Search.FSFreePercentWarning != nil {
+		ths.FreePercent.Critical = *c.Search.FSFreePercentCritical
+		ths.FreePercent.Warning = *c.Search.FSFreePercentWarning
+		ths.FreePercent.Set = true
+	}
+
+	if c.Search.FSInodesFreePercentCritical != nil && c.Search.FSInodesFreePercentWarning != nil {
+		ths.InodesFreePercent.Critical = *c.Search.FSInodesFreePercentCritical
+		ths.InodesFreePercent.Warning = *c.Search.FSInodesFreePercentWarning
+		ths.InodesFreePercent.Set = true
+	}
+
+	return ths
+}
+
+// RequireFSTypes returns the user-specified list of filesystem types
+// permitted for the filesystems hosting specified paths or an empty list if
+// not specified.
+func (c Config) RequireFSTypes() []string {
+	switch {
+	case c.Search.RequireFSTypes != nil:
+		return splitListValues(c.Search.RequireFSTypes)
+	default:
+		return []string{}
+	}
+}
+
+// RequireMountOptions returns the user-specified list of mount options
+// required for the filesystems hosting specified paths or an empty list if
+// not specified.
+func (c Config) RequireMountOptions() []string {
+	switch {
+	case c.Search.RequireMountOptions != nil:
+		return splitListValues(c.Search.RequireMountOptions)
+	default:
+		return []string{}
+	}
+}
+
+// Mount returns the user-specified mount point, filesystem type and mount
+// option assertions for the filesystems hosting specified paths.
+func (c Config) Mount() MountRequirements {
+	return MountRequirements{
+		MountPoint:   c.Search.RequireMountPoint != nil && *c.Search.RequireMountPoint,
+		FSTypes:      c.RequireFSTypes(),
+		MountOptions: c.RequireMountOptions(),
+	}
+}
+
+// splitListValues splits any comma-separated entries in the given list
+// (e.g., a mount option list given as "ro,noexec") into separate values.
+func splitListValues(values []string) []string {
+	split := make([]string, 0, len(values))
+	for _, value := range values {
+		split = append(split, strings.Split(value, ",")...)
+	}
+
+	return split
+}
+
+// FileTypes returns the user-specified file type assertions for specified
+// paths and the content within them.
+func (c Config) FileTypes() FileTypeAssertions {
+	return FileTypeAssertions{
+		PathTypes: splitListValues(c.Search.PathTypes),
+		Allowed:   splitListValues(c.Search.FileTypesAllowed),
+		Denied:    splitListValues(c.Search.FileTypesDenied),
+		Critical:  c.Search.FileTypeCritical != nil && *c.Search.FileTypeCritical,
+		Warning:   c.Search.FileTypeWarning != nil && *c.Search.FileTypeWarning,
+	}
+}
+
+// Xattrs returns the user-specified extended attribute assertions for
+// content in specified paths. Each flag value is a single assertion and is
+// not split on commas as extended attribute values may contain them.
+func (c Config) Xattrs() XattrAssertions {
+	return XattrAssertions{
+		Required:  c.Search.XattrRequired,
+		Forbidden: c.Search.XattrForbidden,
+		Critical:  c.Search.XattrCritical != nil && *c.Search.XattrCritical,
+		Warning:   c.Search.XattrWarning != nil && *c.Search.XattrWarning,
 	}
 }
@@ -0,0 +1,67 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alexflint/go-arg"
+)
+
+func TestXattrsRepeatedFlags(t *testing.T) {
+	var c Config
+
+	parser, err := arg.NewParser(arg.Config{}, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = parser.Parse([]string{
+		"--paths", "/srv/app",
+		"--xattr-required", "user.owner=ops, infra",
+		"--xattr-required", "user.backup-policy",
+		"--xattr-forbidden", "security.capability",
+		"--xattr-critical",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xattrs := c.Xattrs()
+
+	wantRequired := []string{"user.owner=ops, infra", "user.backup-policy"}
+	if !reflect.DeepEqual(xattrs.Required, wantRequired) {
+		t.Errorf("Required = %q; want %q", xattrs.Required, wantRequired)
+	}
+
+	wantForbidden := []string{"security.capability"}
+	if !reflect.DeepEqual(xattrs.Forbidden, wantForbidden) {
+		t.Errorf("Forbidden = %q; want %q", xattrs.Forbidden, wantForbidden)
+	}
+}
+
+func TestXattrsEnvironment(t *testing.T) {
+	t.Setenv("CHECK_PATH_XATTR_REQUIRED", `"user.owner=ops,infra",user.backup-policy`)
+
+	var c Config
+
+	parser, err := arg.NewParser(arg.Config{}, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := parser.Parse([]string{"--paths", "/srv/app"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"user.owner=ops,infra", "user.backup-policy"}
+	if got := c.Xattrs().Required; !reflect.DeepEqual(got, want) {
+		t.Errorf("Required = %q; want %q", got, want)
+	}
+}
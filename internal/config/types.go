@@ -8,6 +8,9 @@
 package config
 
 import (
+	"strings"
+	"time"
+
 	"github.com/alexflint/go-arg"
 	"github.com/rs/zerolog"
 )
@@ -15,9 +18,10 @@ import (
 // FileAgeThresholds represents the user-specified file age thresholds for
 // specified paths.
 type FileAgeThresholds struct {
-	Critical int
-	Warning  int
-	Set      bool
+	Description string
+	Critical    time.Duration
+	Warning     time.Duration
+	Set         bool
 }
 
 // FileSizeThresholds represents the user-specified file size thresholds for
@@ -36,22 +40,295 @@ type FileSizeThresholdsMinMax struct {
 	SizeMax FileSizeThresholds
 }
 
+// FileCountThresholds represents the user-specified file count thresholds
+// for specified paths.
+type FileCountThresholds struct {
+	Description string
+	Critical    int
+	Warning     int
+	Set         bool
+}
+
+// FileCountThresholdsMinMax represents the combined minimum and maximum
+// user-specified file count thresholds for specified paths.
+type FileCountThresholdsMinMax struct {
+	CountMin FileCountThresholds
+	CountMax FileCountThresholds
+}
+
+// LinkCountThresholds represents the user-specified maximum number of hard
+// links permitted for each file in specified paths.
+type LinkCountThresholds struct {
+	Critical int
+	Warning  int
+	Set      bool
+}
+
+// FileModeThresholds represents the user-specified maximum permitted mode
+// masks for either files or directories in specified paths.
+type FileModeThresholds struct {
+	Description string
+	Critical    uint32
+	Warning     uint32
+	Set         bool
+}
+
+// FileModeThresholdsFileDir represents the combined file and directory
+// maximum permitted mode masks for specified paths.
+type FileModeThresholdsFileDir struct {
+	Files       FileModeThresholds
+	Directories FileModeThresholds
+}
+
+// SecurityAudit is a helper struct to record whether user opted to audit
+// specified paths for setuid/setgid executables or world-writable directories
+// missing the sticky bit and if so, at which exit state values.
+type SecurityAudit struct {
+	SetIDCheck                bool
+	SetIDCritical             bool
+	SetIDWarning              bool
+	SetIDAllowed              []string
+	WorldWritableDirsCheck    bool
+	WorldWritableDirsCritical bool
+	WorldWritableDirsWarning  bool
+}
+
+// DirectoryEmptiness is a helper struct to record whether user opted to
+// assert that specified directories are empty or not empty and if so, at
+// which exit state values.
+type DirectoryEmptiness struct {
+	EmptyCheck       bool
+	EmptyCritical    bool
+	EmptyWarning     bool
+	NotEmptyCheck    bool
+	NotEmptyCritical bool
+	NotEmptyWarning  bool
+}
+
+// FileTypeAssertions represents the user-specified file type assertions for
+// specified paths and the content within them.
+type FileTypeAssertions struct {
+
+	// PathTypes is the list of types permitted for the specified paths
+	// themselves.
+	PathTypes []string
+
+	// Allowed is the list of types permitted for content within specified
+	// paths. If empty, all types not explicitly denied are permitted.
+	Allowed []string
+
+	// Denied is the list of types not permitted for content within
+	// specified paths.
+	Denied []string
+
+	// Critical and Warning indicate the exit state used when content of an
+	// unexpected type is found.
+	Critical bool
+	Warning  bool
+}
+
+// PathCheck indicates whether the type of the specified paths is asserted.
+func (fta FileTypeAssertions) PathCheck() bool {
+	return len(fta.PathTypes) > 0
+}
+
+// ContentCheck indicates whether the type of content within specified paths
+// is asserted.
+func (fta FileTypeAssertions) ContentCheck() bool {
+	return len(fta.Allowed) > 0 || len(fta.Denied) > 0
+}
+
+// Any indicates whether any file type assertions were specified.
+func (fta FileTypeAssertions) Any() bool {
+	return fta.PathCheck() || fta.ContentCheck()
+}
+
+// DanglingSymlinks is a helper struct to record whether user opted to check
+// specified paths for symlinks whose targets do not exist and if so, at which
+// exit state values.
+type DanglingSymlinks struct {
+	Check    bool
+	Critical bool
+	Warning  bool
+}
+
+// XattrAssertions represents the user-specified extended attribute
+// assertions for content in specified paths. Each entry is an extended
+// attribute name or shell glob pattern optionally followed by =value.
+type XattrAssertions struct {
+	// Required is the list of extended attributes required on each entry.
+	Required []string
+
+	// Forbidden is the list of extended attributes not permitted on any
+	// entry.
+	Forbidden []string
+
+	// Critical and Warning indicate the exit state used when an entry does
+	// not match the required and forbidden lists.
+	Critical bool
+	Warning  bool
+}
+
+// Any indicates whether any extended attribute assertions were specified.
+func (xa XattrAssertions) Any() bool {
+	return len(xa.Required) > 0 || len(xa.Forbidden) > 0
+}
+
+// SymlinkTargetAssertions represents the user-specified assertions for the
+// target of specified paths which are expected to be symlinks.
+type SymlinkTargetAssertions struct {
+
+	// Target is the absolute path or shell glob the target of each specified
+	// path is expected to match.
+	Target string
+
+	// Newest indicates whether the target of each specified path is expected
+	// to be the newest entry in its parent directory.
+	Newest bool
+
+	// Order is the ordering used to determine the newest entry in the parent
+	// directory of each symlink target.
+	Order string
+}
+
+// Any indicates whether any symlink target assertions were specified.
+func (sta SymlinkTargetAssertions) Any() bool {
+	return sta.Target != "" || sta.Newest
+}
+
 // ResolveIDs is a helper struct to record whether user opted to resolve user
 // and group id values to name values and if so, at which exit state values.
 type ResolveIDs struct {
-	UsernameCheck     bool
-	UsernameCritical  bool
-	UsernameWarning   bool
-	GroupNameCheck    bool
-	GroupNameCritical bool
-	GroupNameWarning  bool
+	UsernameCheck      bool
+	UsernameCritical   bool
+	UsernameWarning    bool
+	GroupNameCheck     bool
+	GroupNameCritical  bool
+	GroupNameWarning   bool
+	OwnerGroupCheck    bool
+	OwnerGroupCritical bool
+	OwnerGroupWarning  bool
 	IDs
 }
 
-// IDs represents username and group name values.
+// Any indicates whether any username, group name or owner group membership
+// checks were specified.
+func (rid ResolveIDs) Any() bool {
+	return rid.UsernameCheck || rid.GroupNameCheck || rid.OwnerGroupCheck
+}
+
+// OrphanedOwners is a helper struct to record whether user opted to check
+// specified paths for content owned by user or group IDs without a matching
+// user or group entry and if so, at which exit state values.
+type OrphanedOwners struct {
+	Check    bool
+	Critical bool
+	Warning  bool
+}
+
+// PermissionBits represents the user-specified permission bits that are
+// required to be present or absent on content in specified paths.
+type PermissionBits struct {
+	RequireGroupRead    bool
+	RequireGroupWrite   bool
+	RequireGroupExecute bool
+	ForbidGroupRead     bool
+	ForbidGroupWrite    bool
+	ForbidGroupExecute  bool
+	ForbidOtherRead     bool
+	ForbidOtherWrite    bool
+	ForbidOtherExecute  bool
+}
+
+// Any indicates whether at least one permission bit assertion is enabled.
+func (pb PermissionBits) Any() bool {
+	return len(pb.Names()) > 0
+}
+
+// String implements the Stringer interface in order to provide a
+// comma-separated list of the enabled permission bit assertions.
+func (pb PermissionBits) String() string {
+	return strings.Join(pb.Names(), ", ")
+}
+
+// Names returns the flag names (minus the exit state suffix) for the enabled
+// permission bit assertions.
+func (pb PermissionBits) Names() []string {
+	assertions := []struct {
+		enabled bool
+		name    string
+	}{
+		{pb.RequireGroupRead, "require-group-read"},
+		{pb.RequireGroupWrite, "require-group-write"},
+		{pb.RequireGroupExecute, "require-group-execute"},
+		{pb.ForbidGroupRead, "forbid-group-read"},
+		{pb.ForbidGroupWrite, "forbid-group-write"},
+		{pb.ForbidGroupExecute, "forbid-group-execute"},
+		{pb.ForbidOtherRead, "forbid-other-read"},
+		{pb.ForbidOtherWrite, "forbid-other-write"},
+		{pb.ForbidOtherExecute, "forbid-other-execute"},
+	}
+
+	enabled := make([]string, 0, len(assertions))
+	for _, assertion := range assertions {
+		if assertion.enabled {
+			enabled = append(enabled, assertion.name)
+		}
+	}
+
+	return enabled
+}
+
+// PermissionsThresholds represents the user-specified permission bit
+// assertions for specified paths and the exit state associated with each.
+type PermissionsThresholds struct {
+	Critical PermissionBits
+	Warning  PermissionBits
+	Set      bool
+}
+
+// FilesystemFreeThresholds represents the user-specified minimum free space
+// or free inode thresholds for the filesystems hosting specified paths.
+type FilesystemFreeThresholds struct {
+	Description string
+	Critical    float64
+	Warning     float64
+	Set         bool
+}
+
+// FilesystemThresholds represents the combined user-specified filesystem
+// free space and free inode thresholds for specified paths.
+type FilesystemThresholds struct {
+	FreeBytes         FilesystemFreeThresholds
+	FreePercent       FilesystemFreeThresholds
+	InodesFreePercent FilesystemFreeThresholds
+}
+
+// Any indicates whether any filesystem thresholds were specified.
+func (ft FilesystemThresholds) Any() bool {
+	return ft.FreeBytes.Set || ft.FreePercent.Set || ft.InodesFreePercent.Set
+}
+
+// MountRequirements represents the user-specified mount point, filesystem
+// type and mount option assertions for the filesystems hosting specified
+// paths.
+type MountRequirements struct {
+	MountPoint   bool
+	FSTypes      []string
+	MountOptions []string
+}
+
+// Any indicates whether any mount requirements were specified.
+func (mr MountRequirements) Any() bool {
+	return mr.MountPoint || len(mr.FSTypes) > 0 || len(mr.MountOptions) > 0
+}
+
+// IDs represents the permitted username, group name and owner group values.
+// Each value is either a name or a numeric ID.
 type IDs struct {
-	Username  string
-	GroupName string
+	Usernames   []string
+	GroupNames  []string
+	OwnerGroups []string
 }
 
 // Search represents options specific to controlling how this application
@@ -59,21 +336,114 @@ type IDs struct {
 type Search struct {
 	PathsInclude             []string `arg:"--paths,env:CHECK_PATH_PATHS_INCLUDE" help:"List of comma or space-separated paths to check."`
 	PathsExclude             []string `arg:"--ignore,env:CHECK_PATH_PATHS_IGNORE" help:"List of comma or space-separated paths to ignore. Does not apply to existence checks."`
+	IncludePatterns          []string `arg:"--include-pattern,env:CHECK_PATH_INCLUDE_PATTERN" help:"List of comma or space-separated patterns. If specified, only content within the specified paths matching one of these patterns is evaluated. Patterns are shell globs (supporting **) matched against the basename and the path relative to the specified path."`
+	ExcludePatterns          []string `arg:"--exclude-pattern,env:CHECK_PATH_EXCLUDE_PATTERN" help:"List of comma or space-separated patterns. Content within the specified paths matching one of these patterns is skipped. Patterns are shell globs (supporting **) matched against the basename and the path relative to the specified path."`
+	PatternRegex             *bool    `arg:"--pattern-regex,env:CHECK_PATH_PATTERN_REGEX" help:"Treat include and exclude patterns as regular expressions instead of shell globs."`
 	Recursive                *bool    `arg:"--recurse,env:CHECK_PATH_RECURSE" help:"Perform recursive search into subdirectories per provided path."`
+	MaxDepth                 *int     `arg:"--max-depth,env:CHECK_PATH_MAX_DEPTH" help:"Maximum depth of content evaluated when performing a recursive search. Content directly within a specified path is at depth 1. Requires the recurse option."`
+	MinDepth                 *int     `arg:"--min-depth,env:CHECK_PATH_MIN_DEPTH" help:"Minimum depth of content evaluated. The specified path is at depth 0 and content directly within it is at depth 1. Content above this depth is searched, but not evaluated."`
+	FollowSymlinks           *bool    `arg:"--follow-symlinks,env:CHECK_PATH_FOLLOW_SYMLINKS" help:"Follow symlinks (including specified paths) when evaluating specified paths. Symlink loops are detected and not descended into."`
+	OneFileSystem            *bool    `arg:"--one-file-system,env:CHECK_PATH_ONE_FILE_SYSTEM" help:"Skip content hosted by a filesystem other than the one hosting each specified path (e.g., bind mounts, network filesystem submounts) when performing a recursive search. Requires the recurse option."`
+	SkipFSTypes              []string `arg:"--skip-fstype,env:CHECK_PATH_SKIP_FSTYPE" help:"List of comma or space-separated filesystem types (e.g., proc, sysfs, nfs4). Skip content hosted by filesystems of these types other than the one hosting each specified path when performing a recursive search. Requires the recurse option."`
 	MissingOK                *bool    `arg:"--missing-ok,env:CHECK_PATH_MISSING_OK" help:"Whether a missing path is considered OK. Incompatible with exists-critical or exists-warning options."`
 	FailFast                 *bool    `arg:"--fail-fast,env:CHECK_PATH_FAIL_FAST" help:"Whether this plugin prioritizes speed of check results over always returning a CRITICAL state result before a WARNING state. This can be useful for processing large collections of content."`
-	AgeCritical              *int     `arg:"--age-critical,env:CHECK_PATH_AGE_CRITICAL" help:"Assert that age for specified paths is less than or equal to the specified age in days, otherwise consider state to be CRITICAL."`
-	AgeWarning               *int     `arg:"--age-warning,env:CHECK_PATH_AGE_WARNING" help:"Assert that age for specified paths is less than or equal to the specified age in days, otherwise consider state to be WARNING."`
+	AgeCritical              *string  `arg:"--age-critical,env:CHECK_PATH_AGE_CRITICAL" help:"Assert that age for specified paths is less than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; days if no unit is given), otherwise consider state to be CRITICAL."`
+	AgeWarning               *string  `arg:"--age-warning,env:CHECK_PATH_AGE_WARNING" help:"Assert that age for specified paths is less than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; days if no unit is given), otherwise consider state to be WARNING."`
+	AgeMinCritical           *string  `arg:"--age-min-critical,env:CHECK_PATH_AGE_MIN_CRITICAL" help:"Assert that age for specified paths is greater than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; days if no unit is given), otherwise consider state to be CRITICAL."`
+	AgeMinWarning            *string  `arg:"--age-min-warning,env:CHECK_PATH_AGE_MIN_WARNING" help:"Assert that age for specified paths is greater than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; days if no unit is given), otherwise consider state to be WARNING."`
+	AgeNewestCritical        *string  `arg:"--age-newest-critical,env:CHECK_PATH_AGE_NEWEST_CRITICAL" help:"Assert that the most recently modified file in specified paths is less than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; hours if no unit is given), otherwise consider state to be CRITICAL."`
+	AgeNewestWarning         *string  `arg:"--age-newest-warning,env:CHECK_PATH_AGE_NEWEST_WARNING" help:"Assert that the most recently modified file in specified paths is less than or equal to the specified age (e.g., 30m, 6h, 2d, 1w; hours if no unit is given), otherwise consider state to be WARNING."`
+	AgeTimestamp             *string  `arg:"--age-timestamp,env:CHECK_PATH_AGE_TIMESTAMP" help:"Timestamp used to determine file age for age, age-min and age-newest checks. One of mtime (modification), ctime (status change), atime (access) or btime (birth; Linux only, requires filesystem support)."`
 	SizeMinCritical          *int64   `arg:"--size-min-critical,env:CHECK_PATH_SIZE_MIN_CRITICAL" help:"Assert that size for specified paths is the specified size in bytes or greater, otherwise consider state to be CRITICAL."`
 	SizeMinWarning           *int64   `arg:"--size-min-warning,env:CHECK_PATH_SIZE_MIN_WARNING" help:"Assert that size for specified paths is the specified size in bytes or greater, otherwise consider state to be WARNING."`
 	SizeMaxCritical          *int64   `arg:"--size-max-critical,env:CHECK_PATH_SIZE_MAX_CRITICAL" help:"Assert that size for specified paths is the specified size in bytes or less, otherwise consider state to be CRITICAL."`
 	SizeMaxWarning           *int64   `arg:"--size-max-warning,env:CHECK_PATH_SIZE_MAX_WARNING" help:"Assert that size for specified paths is the specified size in bytes or less , otherwise consider state to be WARNING."`
+	CountMinCritical         *int     `arg:"--count-min-critical,env:CHECK_PATH_COUNT_MIN_CRITICAL" help:"Assert that the number of files in specified paths is the specified count or greater, otherwise consider state to be CRITICAL."`
+	CountMinWarning          *int     `arg:"--count-min-warning,env:CHECK_PATH_COUNT_MIN_WARNING" help:"Assert that the number of files in specified paths is the specified count or greater, otherwise consider state to be WARNING."`
+	CountMaxCritical         *int     `arg:"--count-max-critical,env:CHECK_PATH_COUNT_MAX_CRITICAL" help:"Assert that the number of files in specified paths is the specified count or less, otherwise consider state to be CRITICAL."`
+	CountMaxWarning          *int     `arg:"--count-max-warning,env:CHECK_PATH_COUNT_MAX_WARNING" help:"Assert that the number of files in specified paths is the specified count or less, otherwise consider state to be WARNING."`
+	FileSizeMaxCritical      *int64   `arg:"--file-size-max-critical,env:CHECK_PATH_FILE_SIZE_MAX_CRITICAL" help:"Assert that size for each individual file in specified paths is the specified size in bytes or less, otherwise consider state to be CRITICAL."`
+	FileSizeMaxWarning       *int64   `arg:"--file-size-max-warning,env:CHECK_PATH_FILE_SIZE_MAX_WARNING" help:"Assert that size for each individual file in specified paths is the specified size in bytes or less, otherwise consider state to be WARNING."`
+	SizeSource               *string  `arg:"--size-source,env:CHECK_PATH_SIZE_SOURCE" help:"Size used for size-min, size-max and file-size-max checks. One of apparent (file length) or allocated (disk space allocated to files; not supported on Windows)."`
+	MaxLinksCritical         *int     `arg:"--max-links-critical,env:CHECK_PATH_MAX_LINKS_CRITICAL" help:"Assert that the number of hard links to each file in specified paths is the specified count or less, otherwise consider state to be CRITICAL."`
+	MaxLinksWarning          *int     `arg:"--max-links-warning,env:CHECK_PATH_MAX_LINKS_WARNING" help:"Assert that the number of hard links to each file in specified paths is the specified count or less, otherwise consider state to be WARNING."`
 	ExistsCritical           *bool    `arg:"--exists-critical,env:CHECK_PATH_EXISTS_CRITICAL" help:"Assert that specified paths are missing, otherwise consider state to be CRITICAL."`
 	ExistsWarning            *bool    `arg:"--exists-warning,env:CHECK_PATH_EXISTS_WARNING" help:"Assert that specified paths are missing, otherwise consider state to be WARNING."`
-	UsernameMissingCritical  *string  `arg:"--username-missing-critical,env:CHECK_PATH_USERNAME_MISSING_CRITICAL" help:"Assert that specified owner/username is present on all content in specified paths, otherwise consider state to be CRITICAL."`
-	UsernameMissingWarning   *string  `arg:"--username-missing-warning,env:CHECK_PATH_USERNAME_MISSING_WARNING" help:"Assert that specified owner/username is present on all content in specified paths, otherwise consider state to be WARNING."`
-	GroupNameMissingCritical *string  `arg:"--group-name-missing-critical,env:CHECK_PATH_GROUP_NAME_MISSING_CRITICAL" help:"Assert that specified group name is present on all content in specified paths, otherwise consider state to be CRITICAL."`
-	GroupNameMissingWarning  *string  `arg:"--group-name-missing-warning,env:CHECK_PATH_GROUP_NAME_MISSING_WARNING" help:"Assert that specified group name is present on all content in specified paths, otherwise consider state to be WARNING."`
+	UsernameMissingCritical  *string  `arg:"--username-missing-critical,env:CHECK_PATH_USERNAME_MISSING_CRITICAL" help:"Assert that one of the specified (comma-separated) owner usernames or numeric user IDs is present on all content in specified paths, otherwise consider state to be CRITICAL."`
+	UsernameMissingWarning   *string  `arg:"--username-missing-warning,env:CHECK_PATH_USERNAME_MISSING_WARNING" help:"Assert that one of the specified (comma-separated) owner usernames or numeric user IDs is present on all content in specified paths, otherwise consider state to be WARNING."`
+	GroupNameMissingCritical *string  `arg:"--group-name-missing-critical,env:CHECK_PATH_GROUP_NAME_MISSING_CRITICAL" help:"Assert that one of the specified (comma-separated) group names or numeric group IDs is present on all content in specified paths, otherwise consider state to be CRITICAL."`
+	GroupNameMissingWarning  *string  `arg:"--group-name-missing-warning,env:CHECK_PATH_GROUP_NAME_MISSING_WARNING" help:"Assert that one of the specified (comma-separated) group names or numeric group IDs is present on all content in specified paths, otherwise consider state to be WARNING."`
+	OrphanedOwnerCritical    *bool    `arg:"--orphaned-owner-critical,env:CHECK_PATH_ORPHANED_OWNER_CRITICAL" help:"Assert that no content in specified paths is owned by a user or group ID without a matching user or group entry, otherwise consider state to be CRITICAL."`
+	OrphanedOwnerWarning     *bool    `arg:"--orphaned-owner-warning,env:CHECK_PATH_ORPHANED_OWNER_WARNING" help:"Assert that no content in specified paths is owned by a user or group ID without a matching user or group entry, otherwise consider state to be WARNING."`
+	OwnerGroupMemberCritical *string  `arg:"--owner-group-member-critical,env:CHECK_PATH_OWNER_GROUP_MEMBER_CRITICAL" help:"Assert that the owner of all content in specified paths is a (primary or supplementary) member of one of the specified (comma-separated) group names or numeric group IDs, otherwise consider state to be CRITICAL."`
+	OwnerGroupMemberWarning  *string  `arg:"--owner-group-member-warning,env:CHECK_PATH_OWNER_GROUP_MEMBER_WARNING" help:"Assert that the owner of all content in specified paths is a (primary or supplementary) member of one of the specified (comma-separated) group names or numeric group IDs, otherwise consider state to be WARNING."`
+
+	RequireGroupReadCritical    *bool `arg:"--require-group-read-critical,env:CHECK_PATH_REQUIRE_GROUP_READ_CRITICAL" help:"Assert that group read permission is present on all content in specified paths, otherwise consider state to be CRITICAL."`
+	RequireGroupReadWarning     *bool `arg:"--require-group-read-warning,env:CHECK_PATH_REQUIRE_GROUP_READ_WARNING" help:"Assert that group read permission is present on all content in specified paths, otherwise consider state to be WARNING."`
+	RequireGroupWriteCritical   *bool `arg:"--require-group-write-critical,env:CHECK_PATH_REQUIRE_GROUP_WRITE_CRITICAL" help:"Assert that group write permission is present on all content in specified paths, otherwise consider state to be CRITICAL."`
+	RequireGroupWriteWarning    *bool `arg:"--require-group-write-warning,env:CHECK_PATH_REQUIRE_GROUP_WRITE_WARNING" help:"Assert that group write permission is present on all content in specified paths, otherwise consider state to be WARNING."`
+	RequireGroupExecuteCritical *bool `arg:"--require-group-execute-critical,env:CHECK_PATH_REQUIRE_GROUP_EXECUTE_CRITICAL" help:"Assert that group execute permission is present on all content in specified paths, otherwise consider state to be CRITICAL."`
+	RequireGroupExecuteWarning  *bool `arg:"--require-group-execute-warning,env:CHECK_PATH_REQUIRE_GROUP_EXECUTE_WARNING" help:"Assert that group execute permission is present on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidGroupReadCritical     *bool `arg:"--forbid-group-read-critical,env:CHECK_PATH_FORBID_GROUP_READ_CRITICAL" help:"Assert that group read permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidGroupReadWarning      *bool `arg:"--forbid-group-read-warning,env:CHECK_PATH_FORBID_GROUP_READ_WARNING" help:"Assert that group read permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidGroupWriteCritical    *bool `arg:"--forbid-group-write-critical,env:CHECK_PATH_FORBID_GROUP_WRITE_CRITICAL" help:"Assert that group write permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidGroupWriteWarning     *bool `arg:"--forbid-group-write-warning,env:CHECK_PATH_FORBID_GROUP_WRITE_WARNING" help:"Assert that group write permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidGroupExecuteCritical  *bool `arg:"--forbid-group-execute-critical,env:CHECK_PATH_FORBID_GROUP_EXECUTE_CRITICAL" help:"Assert that group execute permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidGroupExecuteWarning   *bool `arg:"--forbid-group-execute-warning,env:CHECK_PATH_FORBID_GROUP_EXECUTE_WARNING" help:"Assert that group execute permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidOtherReadCritical     *bool `arg:"--forbid-other-read-critical,env:CHECK_PATH_FORBID_OTHER_READ_CRITICAL" help:"Assert that other read permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidOtherReadWarning      *bool `arg:"--forbid-other-read-warning,env:CHECK_PATH_FORBID_OTHER_READ_WARNING" help:"Assert that other read permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidOtherWriteCritical    *bool `arg:"--forbid-other-write-critical,env:CHECK_PATH_FORBID_OTHER_WRITE_CRITICAL" help:"Assert that other write permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidOtherWriteWarning     *bool `arg:"--forbid-other-write-warning,env:CHECK_PATH_FORBID_OTHER_WRITE_WARNING" help:"Assert that other write permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+	ForbidOtherExecuteCritical  *bool `arg:"--forbid-other-execute-critical,env:CHECK_PATH_FORBID_OTHER_EXECUTE_CRITICAL" help:"Assert that other execute permission is absent on all content in specified paths, otherwise consider state to be CRITICAL."`
+	ForbidOtherExecuteWarning   *bool `arg:"--forbid-other-execute-warning,env:CHECK_PATH_FORBID_OTHER_EXECUTE_WARNING" help:"Assert that other execute permission is absent on all content in specified paths, otherwise consider state to be WARNING."`
+
+	ModeMaxCritical    *string `arg:"--mode-max-critical,env:CHECK_PATH_MODE_MAX_CRITICAL" help:"Assert that the mode for files in specified paths grants no permissions outside of the specified octal mask (e.g., 0640), otherwise consider state to be CRITICAL."`
+	ModeMaxWarning     *string `arg:"--mode-max-warning,env:CHECK_PATH_MODE_MAX_WARNING" help:"Assert that the mode for files in specified paths grants no permissions outside of the specified octal mask (e.g., 0600), otherwise consider state to be WARNING."`
+	DirModeMaxCritical *string `arg:"--dir-mode-max-critical,env:CHECK_PATH_DIR_MODE_MAX_CRITICAL" help:"Assert that the mode for directories in specified paths grants no permissions outside of the specified octal mask (e.g., 0750), otherwise consider state to be CRITICAL."`
+	DirModeMaxWarning  *string `arg:"--dir-mode-max-warning,env:CHECK_PATH_DIR_MODE_MAX_WARNING" help:"Assert that the mode for directories in specified paths grants no permissions outside of the specified octal mask (e.g., 0700), otherwise consider state to be WARNING."`
+
+	SetIDCritical             *bool    `arg:"--suid-sgid-critical,env:CHECK_PATH_SUID_SGID_CRITICAL" help:"Assert that no setuid or setgid executables (aside from those specified via suid-sgid-allowed) are present in specified paths, otherwise consider state to be CRITICAL."`
+	SetIDWarning              *bool    `arg:"--suid-sgid-warning,env:CHECK_PATH_SUID_SGID_WARNING" help:"Assert that no setuid or setgid executables (aside from those specified via suid-sgid-allowed) are present in specified paths, otherwise consider state to be WARNING."`
+	SetIDAllowed              []string `arg:"--suid-sgid-allowed,env:CHECK_PATH_SUID_SGID_ALLOWED" help:"List of comma or space-separated absolute paths to setuid or setgid executables which are expected and should not be reported."`
+	WorldWritableDirsCritical *bool    `arg:"--world-writable-dirs-critical,env:CHECK_PATH_WORLD_WRITABLE_DIRS_CRITICAL" help:"Assert that no world-writable directories missing the sticky bit are present in specified paths, otherwise consider state to be CRITICAL."`
+	WorldWritableDirsWarning  *bool    `arg:"--world-writable-dirs-warning,env:CHECK_PATH_WORLD_WRITABLE_DIRS_WARNING" help:"Assert that no world-writable directories missing the sticky bit are present in specified paths, otherwise consider state to be WARNING."`
+
+	FSFreeBytesCritical         *int64   `arg:"--fs-free-bytes-critical,env:CHECK_PATH_FS_FREE_BYTES_CRITICAL" help:"Assert that the filesystem hosting each specified path has at least the specified free space in bytes, otherwise consider state to be CRITICAL."`
+	FSFreeBytesWarning          *int64   `arg:"--fs-free-bytes-warning,env:CHECK_PATH_FS_FREE_BYTES_WARNING" help:"Assert that the filesystem hosting each specified path has at least the specified free space in bytes, otherwise consider state to be WARNING."`
+	FSFreePercentCritical       *float64 `arg:"--fs-free-percent-critical,env:CHECK_PATH_FS_FREE_PERCENT_CRITICAL" help:"Assert that the filesystem hosting each specified path has at least the specified percentage of free space, otherwise consider state to be CRITICAL."`
+	FSFreePercentWarning        *float64 `arg:"--fs-free-percent-warning,env:CHECK_PATH_FS_FREE_PERCENT_WARNING" help:"Assert that the filesystem hosting each specified path has at least the specified percentage of free space, otherwise consider state to be WARNING."`
+	FSInodesFreePercentCritical *float64 `arg:"--fs-inodes-free-percent-critical,env:CHECK_PATH_FS_INODES_FREE_PERCENT_CRITICAL" help:"Assert that the filesystem hosting each specified path has at least the specified percentage of free inodes, otherwise consider state to be CRITICAL."`
+	FSInodesFreePercentWarning  *float64 `arg:"--fs-inodes-free-percent-warning,env:CHECK_PATH_FS_INODES_FREE_PERCENT_WARNING" help:"Assert that the filesystem hosting each specified path has at least the specified percentage of free inodes, otherwise consider state to be WARNING."`
+
+	RequireMountPoint   *bool    `arg:"--require-mountpoint,env:CHECK_PATH_REQUIRE_MOUNTPOINT" help:"Assert that each specified path is a mount point, otherwise consider state to be CRITICAL."`
+	RequireFSTypes      []string `arg:"--require-fstype,env:CHECK_PATH_REQUIRE_FSTYPE" help:"List of comma or space-separated filesystem types (e.g., nfs4, cifs). Assert that the filesystem hosting each specified path is one of these types, otherwise consider state to be CRITICAL."`
+	RequireMountOptions []string `arg:"--require-mount-option,env:CHECK_PATH_REQUIRE_MOUNT_OPTION" help:"List of comma or space-separated mount options (e.g., ro, noexec). Assert that the filesystem hosting each specified path is mounted with all of these options, otherwise consider state to be CRITICAL."`
+
+	AssertEmptyCritical    *bool `arg:"--assert-empty-critical,env:CHECK_PATH_ASSERT_EMPTY_CRITICAL" help:"Assert that specified directories are empty (aside from ignored or excluded content), otherwise consider state to be CRITICAL."`
+	AssertEmptyWarning     *bool `arg:"--assert-empty-warning,env:CHECK_PATH_ASSERT_EMPTY_WARNING" help:"Assert that specified directories are empty (aside from ignored or excluded content), otherwise consider state to be WARNING."`
+	AssertNotEmptyCritical *bool `arg:"--assert-not-empty-critical,env:CHECK_PATH_ASSERT_NOT_EMPTY_CRITICAL" help:"Assert that specified directories are not empty (aside from ignored or excluded content), otherwise consider state to be CRITICAL."`
+	AssertNotEmptyWarning  *bool `arg:"--assert-not-empty-warning,env:CHECK_PATH_ASSERT_NOT_EMPTY_WARNING" help:"Assert that specified directories are not empty (aside from ignored or excluded content), otherwise consider state to be WARNING."`
+
+	PathTypes        []string `arg:"--path-type,env:CHECK_PATH_PATH_TYPE" help:"List of comma or space-separated file types (file, dir, symlink, socket, fifo, block-device, char-device). Assert that each specified path is one of these types, otherwise consider state to be CRITICAL."`
+	FileTypesAllowed []string `arg:"--file-type-allowed,env:CHECK_PATH_FILE_TYPE_ALLOWED" help:"List of comma or space-separated file types (file, dir, symlink, socket, fifo, block-device, char-device) permitted for content within specified paths. Requires file-type-critical or file-type-warning."`
+	FileTypesDenied  []string `arg:"--file-type-denied,env:CHECK_PATH_FILE_TYPE_DENIED" help:"List of comma or space-separated file types (file, dir, symlink, socket, fifo, block-device, char-device) not permitted for content within specified paths. Requires file-type-critical or file-type-warning."`
+	FileTypeCritical *bool    `arg:"--file-type-critical,env:CHECK_PATH_FILE_TYPE_CRITICAL" help:"Assert that content within specified paths matches the file-type-allowed and file-type-denied lists, otherwise consider state to be CRITICAL."`
+	FileTypeWarning  *bool    `arg:"--file-type-warning,env:CHECK_PATH_FILE_TYPE_WARNING" help:"Assert that content within specified paths matches the file-type-allowed and file-type-denied lists, otherwise consider state to be WARNING."`
+
+	DanglingSymlinksCritical *bool `arg:"--dangling-symlinks-critical,env:CHECK_PATH_DANGLING_SYMLINKS_CRITICAL" help:"Assert that no symlinks whose targets do not exist are present in specified paths, otherwise consider state to be CRITICAL."`
+	DanglingSymlinksWarning  *bool `arg:"--dangling-symlinks-warning,env:CHECK_PATH_DANGLING_SYMLINKS_WARNING" help:"Assert that no symlinks whose targets do not exist are present in specified paths, otherwise consider state to be WARNING."`
+
+	SymlinkTarget       *string `arg:"--symlink-target,env:CHECK_PATH_SYMLINK_TARGET" help:"Absolute path or shell glob (e.g., /srv/app/releases/*). Assert that each specified path is a symlink whose target matches, otherwise consider state to be CRITICAL."`
+	SymlinkTargetNewest *bool   `arg:"--symlink-target-newest,env:CHECK_PATH_SYMLINK_TARGET_NEWEST" help:"Assert that each specified path is a symlink whose target is the newest entry in its parent directory (see symlink-target-order), otherwise consider state to be CRITICAL."`
+	SymlinkTargetOrder  *string `arg:"--symlink-target-order,env:CHECK_PATH_SYMLINK_TARGET_ORDER" help:"Ordering used by symlink-target-newest to determine the newest entry. One of version (by name, comparing numbers numerically), name (lexical) or mtime (modification time). Requires symlink-target-newest."`
+
+	PasswdFile *string `arg:"--passwd-file,env:CHECK_PATH_PASSWD_FILE" help:"Fully-qualified path to an alternate passwd file (e.g., /srv/container/rootfs/etc/passwd) used to resolve user IDs to usernames for ownership checks."`
+	GroupFile  *string `arg:"--group-file,env:CHECK_PATH_GROUP_FILE" help:"Fully-qualified path to an alternate group file (e.g., /srv/container/rootfs/etc/group) used to resolve group IDs to group names for ownership checks."`
+
+	XattrRequired  []string `arg:"--xattr-required,separate,env:CHECK_PATH_XATTR_REQUIRED" help:"Extended attribute name or shell glob pattern (e.g., user.*), optionally followed by =value. Repeat for each required extended attribute; values may contain commas and spaces. Assert that each entry in specified paths has a matching extended attribute. Requires xattr-critical or xattr-warning."`
+	XattrForbidden []string `arg:"--xattr-forbidden,separate,env:CHECK_PATH_XATTR_FORBIDDEN" help:"Extended attribute name or shell glob pattern (e.g., security.capability), optionally followed by =value. Repeat for each forbidden extended attribute; values may contain commas and spaces. Assert that no entry in specified paths has a matching extended attribute. Requires xattr-critical or xattr-warning."`
+	XattrCritical  *bool    `arg:"--xattr-critical,env:CHECK_PATH_XATTR_CRITICAL" help:"Assert that entries in specified paths match the xattr-required and xattr-forbidden lists, otherwise consider state to be CRITICAL."`
+	XattrWarning   *bool    `arg:"--xattr-warning,env:CHECK_PATH_XATTR_WARNING" help:"Assert that entries in specified paths match the xattr-required and xattr-forbidden lists, otherwise consider state to be WARNING."`
 }
 
 // Logging represents options specific to how this application handles
@@ -10,8 +10,15 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/atc0005/check-path/internal/paths"
+	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/atc0005/check-path/internal/units"
 	"github.com/atc0005/go-nagios"
 )
 
@@ -31,8 +38,29 @@ var ErrUsernameIsEmpty = errors.New("username is empty string")
 // empty string.
 var ErrGroupNameIsEmpty = errors.New("group name is empty string")
 
+// ErrIDOutOfRange is returned by validation checks if a numeric user or
+// group ID is outside of the range of valid IDs.
+var ErrIDOutOfRange = errors.New("numeric user or group ID out of range")
+
+// ErrXattrNameIsEmpty is returned by validation checks if an extended
+// attribute name is an empty string.
+var ErrXattrNameIsEmpty = errors.New("extended attribute name is empty string")
+
+// ErrXattrNameHasSpaces is returned by validation checks if an extended
+// attribute name contains spaces.
+var ErrXattrNameHasSpaces = errors.New("extended attribute name contains spaces")
+
+// ErrMountValueIsEmpty is returned by validation checks if a filesystem type
+// or mount option is an empty string.
+var ErrMountValueIsEmpty = errors.New("filesystem type or mount option is empty string")
+
+// ErrMountValueHasSpaces is returned by validation checks if a filesystem type
+// or mount option contains spaces.
+var ErrMountValueHasSpaces = errors.New("filesystem type or mount option contains spaces")
+
 // usernameValidation is intended to help concentrate validation checks
-// specific to usernames in one place.
+// specific to usernames in one place. The given value may be a
+// comma-separated list of usernames or numeric user IDs.
 func usernameValidation(username string) error {
 
 	// TODO: Extend validation to cover most common username, group name
@@ -40,12 +68,18 @@ func usernameValidation(username string) error {
 	// https://unix.stackexchange.com/questions/157426/what-is-the-regex-to-validate-linux-users
 	// https://github.com/systemd/systemd/issues/6237
 
-	if username == "" {
-		return ErrUsernameIsEmpty
-	}
+	for _, entry := range strings.Split(username, ",") {
+		if entry == "" {
+			return ErrUsernameIsEmpty
+		}
 
-	if strings.Contains(username, " ") {
-		return ErrUserNameHasSpaces
+		if strings.Contains(entry, " ") {
+			return ErrUserNameHasSpaces
+		}
+
+		if err := numericIDValidation(entry); err != nil {
+			return err
+		}
 	}
 
 	// TODO: Extend with further checks
@@ -54,7 +88,8 @@ func usernameValidation(username string) error {
 }
 
 // groupNameValidation is intended to help concentrate validation checks
-// specific to group names in one place.
+// specific to group names in one place. The given value may be a
+// comma-separated list of group names or numeric group IDs.
 func groupNameValidation(groupName string) error {
 
 	// TODO: Extend validation to cover most common username, group name
@@ -62,12 +97,18 @@ func groupNameValidation(groupName string) error {
 	// https://unix.stackexchange.com/questions/157426/what-is-the-regex-to-validate-linux-users
 	// https://github.com/systemd/systemd/issues/6237
 
-	if groupName == "" {
-		return ErrGroupNameIsEmpty
-	}
+	for _, entry := range strings.Split(groupName, ",") {
+		if entry == "" {
+			return ErrGroupNameIsEmpty
+		}
+
+		if strings.Contains(entry, " ") {
+			return ErrGroupNameHasSpaces
+		}
 
-	if strings.Contains(groupName, " ") {
-		return ErrGroupNameHasSpaces
+		if err := numericIDValidation(entry); err != nil {
+			return err
+		}
 	}
 
 	// TODO: Extend with further checks
@@ -75,6 +116,286 @@ func groupNameValidation(groupName string) error {
 	return nil
 }
 
+// numericIDValidation asserts that the given username or group name value,
+// if numeric, is within the range of valid user and group IDs. Values which
+// are not numeric are treated as names and are not evaluated.
+func numericIDValidation(value string) error {
+	if !textutils.IsDigits(value) {
+		return nil
+	}
+
+	if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+		return fmt.Errorf("%w: %s", ErrIDOutOfRange, value)
+	}
+
+	return nil
+}
+
+// patternValidation asserts that the given include or exclude pattern is a
+// valid shell glob or, if requested, a valid regular expression.
+func patternValidation(pattern string, useRegex bool) error {
+
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("empty include or exclude pattern provided")
+	}
+
+	var err error
+	switch {
+	case useRegex:
+		_, err = regexp.Compile(pattern)
+	default:
+		_, err = textutils.GlobToRegexp(pattern)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid include or exclude pattern %q: %w", pattern, err)
+	}
+
+	return nil
+
+}
+
+// mountValueValidation is intended to help concentrate validation checks
+// specific to filesystem types and mount options in one place.
+func mountValueValidation(value string) error {
+
+	if value == "" {
+		return ErrMountValueIsEmpty
+	}
+
+	if strings.ContainsAny(value, " \t") {
+		return ErrMountValueHasSpaces
+	}
+
+	return nil
+}
+
+// xattrValidation asserts that the given extended attribute specification
+// has a non-empty name pattern without spaces which is a valid shell glob.
+func xattrValidation(spec string) error {
+
+	namePattern, _, _ := strings.Cut(spec, "=")
+
+	switch {
+	case namePattern == "":
+		return ErrXattrNameIsEmpty
+
+	case strings.Contains(namePattern, " "):
+		return ErrXattrNameHasSpaces
+	}
+
+	if _, err := textutils.GlobToRegexp(namePattern); err != nil {
+		return fmt.Errorf("invalid extended attribute name pattern: %w", err)
+	}
+
+	return nil
+}
+
+// fileTypeValidation asserts that the given file type is one of the
+// supported file types.
+func fileTypeValidation(fileType string) error {
+
+	switch fileType {
+	case FileTypeRegular:
+	case FileTypeDirectory:
+	case FileTypeSymlink:
+	case FileTypeSocket:
+	case FileTypeNamedPipe:
+	case FileTypeBlockDevice:
+	case FileTypeCharDevice:
+	case FileTypeIrregular:
+	default:
+		return fmt.Errorf(
+			"unsupported file type %q; supported values: %s, %s, %s, %s, %s, %s, %s, %s",
+			fileType,
+			FileTypeRegular,
+			FileTypeDirectory,
+			FileTypeSymlink,
+			FileTypeSocket,
+			FileTypeNamedPipe,
+			FileTypeBlockDevice,
+			FileTypeCharDevice,
+			FileTypeIrregular,
+		)
+	}
+
+	return nil
+}
+
+// filesystemFreeValidation is used as a helper validation function for
+// filesystem free space and free inode checks to reduce code duplication. A
+// maxValue of 0 indicates that there is no upper limit for the values.
+func filesystemFreeValidation(description string, fsCritical *float64, fsWarning *float64, maxValue float64) error {
+
+	const (
+		tmplNotSetErrMsg                  string = "%s not specified for %s threshold; both values required if checking %s"
+		tmplInvalidErrMsg                 string = "provided %s (%v) not valid for %s threshold"
+		tmplWarningLessThanCriticalErrMsg string = "provided %s %s (%v) less than %s %s (%v)"
+		tmplWarningEqualToCriticalErrMsg  string = "provided %s %s (%v) equal to %s %s (%v)"
+	)
+
+	if fsCritical == nil {
+		return fmt.Errorf(tmplNotSetErrMsg, description, nagios.StateCRITICALLabel, description)
+	}
+
+	if fsWarning == nil {
+		return fmt.Errorf(tmplNotSetErrMsg, description, nagios.StateWARNINGLabel, description)
+	}
+
+	if *fsCritical <= 0 || (maxValue > 0 && *fsCritical > maxValue) {
+		return fmt.Errorf(tmplInvalidErrMsg, description, *fsCritical, nagios.StateCRITICALLabel)
+	}
+
+	if *fsWarning <= 0 || (maxValue > 0 && *fsWarning > maxValue) {
+		return fmt.Errorf(tmplInvalidErrMsg, description, *fsWarning, nagios.StateWARNINGLabel)
+	}
+
+	// Running low on free space is a problem, so the WARNING threshold is
+	// crossed before (at a higher value than) the CRITICAL threshold.
+	if *fsWarning < *fsCritical {
+		return fmt.Errorf(
+			tmplWarningLessThanCriticalErrMsg,
+			nagios.StateWARNINGLabel,
+			description,
+			*fsWarning,
+			nagios.StateCRITICALLabel,
+			description,
+			*fsCritical,
+		)
+	}
+
+	if *fsWarning == *fsCritical {
+		return fmt.Errorf(
+			tmplWarningEqualToCriticalErrMsg,
+			nagios.StateWARNINGLabel,
+			description,
+			*fsWarning,
+			nagios.StateCRITICALLabel,
+			description,
+			*fsCritical,
+		)
+	}
+
+	return nil
+
+}
+
+// pathAgeValidation is used as a helper validation function for age checks
+// to reduce code duplication.
+func pathAgeValidation(ths FileAgeThresholds, ageCritical *string, ageWarning *string, defaultUnit time.Duration) error {
+
+	const (
+		tmplNotSetErrMsg                     string = "%s age not specified for %s threshold; both values required if checking %s age"
+		tmplInvalidErrMsg                    string = "provided %s age not valid for %s threshold: %w"
+		tmplTooSmallErrMsg                   string = "provided %s age (%s) not valid for %s threshold"
+		tmplWarningGreaterThanCriticalErrMsg string = "provided %s %s age (%s) greater than %s %s age (%s)"
+		tmplWarningLessThanCriticalErrMsg    string = "provided %s %s age (%s) less than %s %s age (%s)"
+		tmplWarningEqualToCriticalErrMsg     string = "provided %s %s age (%s) equal to %s %s age (%s)"
+	)
+
+	if ageCritical == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			ths.Description,
+		)
+	}
+
+	if ageWarning == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+		)
+	}
+
+	critical, err := units.ParseDuration(*ageCritical, defaultUnit)
+	if err != nil {
+		return fmt.Errorf(
+			tmplInvalidErrMsg,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			err,
+		)
+	}
+
+	warning, err := units.ParseDuration(*ageWarning, defaultUnit)
+	if err != nil {
+		return fmt.Errorf(
+			tmplInvalidErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			err,
+		)
+	}
+
+	if critical <= 0 {
+		return fmt.Errorf(
+			tmplTooSmallErrMsg,
+			ths.Description,
+			*ageCritical,
+			nagios.StateCRITICALLabel,
+		)
+	}
+
+	if warning <= 0 {
+		return fmt.Errorf(
+			tmplTooSmallErrMsg,
+			ths.Description,
+			*ageWarning,
+			nagios.StateWARNINGLabel,
+		)
+	}
+
+	switch {
+	case ths.Description == ageMaxDescription,
+		ths.Description == ageNewestDescription:
+		if warning > critical {
+			return fmt.Errorf(
+				tmplWarningGreaterThanCriticalErrMsg,
+				nagios.StateWARNINGLabel,
+				ths.Description,
+				units.FormatDuration(warning),
+				nagios.StateCRITICALLabel,
+				ths.Description,
+				units.FormatDuration(critical),
+			)
+		}
+
+	// Files younger than the minimum age are a problem, so the CRITICAL
+	// threshold is crossed by younger files than the WARNING threshold.
+	case ths.Description == ageMinDescription:
+		if warning < critical {
+			return fmt.Errorf(
+				tmplWarningLessThanCriticalErrMsg,
+				nagios.StateWARNINGLabel,
+				ths.Description,
+				units.FormatDuration(warning),
+				nagios.StateCRITICALLabel,
+				ths.Description,
+				units.FormatDuration(critical),
+			)
+		}
+	}
+
+	if warning == critical {
+		return fmt.Errorf(
+			tmplWarningEqualToCriticalErrMsg,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+			units.FormatDuration(warning),
+			nagios.StateCRITICALLabel,
+			ths.Description,
+			units.FormatDuration(critical),
+		)
+	}
+
+	return nil
+
+}
+
 // pathSizeValidation is used as a helper validation function for size checks
 // to reduce code duplication.
 func pathSizeValidation(ths FileSizeThresholds, sizeCritical *int64, sizeWarning *int64) error {
@@ -124,7 +445,8 @@ func pathSizeValidation(ths FileSizeThresholds, sizeCritical *int64, sizeWarning
 	}
 
 	switch {
-	case ths.Description == sizeMaxDescription:
+	case ths.Description == sizeMaxDescription,
+		ths.Description == fileSizeMaxDescription:
 		if *sizeWarning > *sizeCritical {
 			return fmt.Errorf(
 				tmplWarningGreaterThanCriticalErrMsg,
@@ -166,6 +488,282 @@ func pathSizeValidation(ths FileSizeThresholds, sizeCritical *int64, sizeWarning
 
 }
 
+// pathCountValidation is used as a helper validation function for file count
+// checks to reduce code duplication.
+func pathCountValidation(ths FileCountThresholds, countCritical *int, countWarning *int) error {
+
+	const (
+		tmplNotSetErrMsg                     string = "%s file count not specified for %s threshold; both values required if checking %s file count"
+		tmplTooSmallErrMsg                   string = "provided %s file count (%d) not valid for %s threshold"
+		tmplWarningGreaterThanCriticalErrMsg string = "provided %s %s file count (%d) greater than %s %s file count (%d)"
+		tmplWarningLessThanCriticalErrMsg    string = "provided %s %s file count (%d) less than %s %s file count (%d)"
+		tmplWarningEqualToCriticalErrMsg     string = "provided %s %s file count (%d) equal to %s %s file count (%d)"
+	)
+
+	if countCritical == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			ths.Description,
+		)
+	}
+
+	if countWarning == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+		)
+	}
+
+	// Unlike size thresholds, a file count of zero is a valid threshold
+	// (e.g., "alert if any files are present").
+	if *countCritical < 0 {
+		return fmt.Errorf(
+			tmplTooSmallErrMsg,
+			ths.Description,
+			*countCritical,
+			nagios.StateCRITICALLabel,
+		)
+	}
+
+	if *countWarning < 0 {
+		return fmt.Errorf(
+			tmplTooSmallErrMsg,
+			ths.Description,
+			*countWarning,
+			nagios.StateWARNINGLabel,
+		)
+	}
+
+	switch {
+	case ths.Description == countMaxDescription:
+		if *countWarning > *countCritical {
+			return fmt.Errorf(
+				tmplWarningGreaterThanCriticalErrMsg,
+				ths.Description,
+				nagios.StateWARNINGLabel,
+				*countWarning,
+				ths.Description,
+				nagios.StateCRITICALLabel,
+				*countCritical,
+			)
+		}
+	case ths.Description == countMinDescription:
+		if *countWarning < *countCritical {
+			return fmt.Errorf(
+				tmplWarningLessThanCriticalErrMsg,
+				ths.Description,
+				nagios.StateWARNINGLabel,
+				*countWarning,
+				ths.Description,
+				nagios.StateCRITICALLabel,
+				*countCritical,
+			)
+		}
+	}
+
+	if *countWarning == *countCritical {
+		return fmt.Errorf(
+			tmplWarningEqualToCriticalErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			*countWarning,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			*countCritical,
+		)
+	}
+
+	return nil
+
+}
+
+// maxLinksValidation is used as a helper validation function for maximum
+// hard link count checks. Every file has at least one link, so thresholds
+// below one are rejected. The WARNING threshold may be equal to the CRITICAL
+// threshold (e.g., "any additional hard link is CRITICAL").
+func maxLinksValidation(linksCritical *int, linksWarning *int) error {
+
+	const (
+		tmplNotSetErrMsg   string = "maximum links count not specified for %s threshold; both values required if checking maximum links"
+		tmplTooSmallErrMsg string = "provided maximum links count (%d) not valid for %s threshold; must be 1 or greater"
+	)
+
+	if linksCritical == nil {
+		return fmt.Errorf(tmplNotSetErrMsg, nagios.StateCRITICALLabel)
+	}
+
+	if linksWarning == nil {
+		return fmt.Errorf(tmplNotSetErrMsg, nagios.StateWARNINGLabel)
+	}
+
+	if *linksCritical < 1 {
+		return fmt.Errorf(tmplTooSmallErrMsg, *linksCritical, nagios.StateCRITICALLabel)
+	}
+
+	if *linksWarning < 1 {
+		return fmt.Errorf(tmplTooSmallErrMsg, *linksWarning, nagios.StateWARNINGLabel)
+	}
+
+	if *linksWarning > *linksCritical {
+		return fmt.Errorf(
+			"provided %s maximum links count (%d) greater than %s maximum links count (%d)",
+			nagios.StateWARNINGLabel,
+			*linksWarning,
+			nagios.StateCRITICALLabel,
+			*linksCritical,
+		)
+	}
+
+	return nil
+
+}
+
+// permissionsValidation is used as a helper validation function for
+// permission bit assertions. Each assertion may only be specified for one
+// exit state and an assertion may not require and forbid the same bit.
+func permissionsValidation(ths PermissionsThresholds) error {
+
+	criticalNames := ths.Critical.Names()
+	warningNames := ths.Warning.Names()
+
+	for _, name := range criticalNames {
+		if textutils.InList(name, warningNames) {
+			return fmt.Errorf(
+				"'%s-critical' and '%s-warning' specified; only one is permitted",
+				name,
+				name,
+			)
+		}
+	}
+
+	allNames := make([]string, 0, len(criticalNames)+len(warningNames))
+	allNames = append(allNames, criticalNames...)
+	allNames = append(allNames, warningNames...)
+
+	for _, name := range allNames {
+		if !strings.HasPrefix(name, "require-") {
+			continue
+		}
+
+		conflict := strings.Replace(name, "require-", "forbid-", 1)
+		if textutils.InList(conflict, allNames) {
+			return fmt.Errorf(
+				"'%s' and '%s' assertions specified; only one is permitted",
+				name,
+				conflict,
+			)
+		}
+	}
+
+	return nil
+
+}
+
+// parseModeMask parses the given octal mode mask (e.g., 0640 or 640) and
+// returns the numeric value or an error if the value is invalid.
+func parseModeMask(mask string) (uint32, error) {
+
+	mask = strings.TrimPrefix(strings.TrimSpace(mask), "0o")
+
+	value, err := strconv.ParseUint(mask, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid octal mode mask %q: %w", mask, err)
+	}
+
+	if value > maxModeMask {
+		return 0, fmt.Errorf(
+			"invalid octal mode mask %q: greater than %#o",
+			mask,
+			maxModeMask,
+		)
+	}
+
+	return uint32(value), nil
+}
+
+// modeMaskValidation is used as a helper validation function for maximum
+// mode mask checks to reduce code duplication.
+func modeMaskValidation(ths FileModeThresholds, modeCritical *string, modeWarning *string) error {
+
+	const (
+		tmplNotSetErrMsg                  string = "maximum %s mode mask not specified for %s threshold; both values required if checking %s mode"
+		tmplInvalidErrMsg                 string = "provided maximum %s mode mask not valid for %s threshold: %w"
+		tmplWarningNotSubsetOfCriticalMsg string = "provided %s maximum %s mode mask (%#o) permits bits not permitted by %s maximum %s mode mask (%#o)"
+		tmplWarningEqualToCriticalErrMsg  string = "provided %s maximum %s mode mask (%#o) equal to %s maximum %s mode mask (%#o)"
+	)
+
+	if modeCritical == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			ths.Description,
+		)
+	}
+
+	if modeWarning == nil {
+		return fmt.Errorf(
+			tmplNotSetErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+		)
+	}
+
+	criticalMask, err := parseModeMask(*modeCritical)
+	if err != nil {
+		return fmt.Errorf(
+			tmplInvalidErrMsg,
+			ths.Description,
+			nagios.StateCRITICALLabel,
+			err,
+		)
+	}
+
+	warningMask, err := parseModeMask(*modeWarning)
+	if err != nil {
+		return fmt.Errorf(
+			tmplInvalidErrMsg,
+			ths.Description,
+			nagios.StateWARNINGLabel,
+			err,
+		)
+	}
+
+	// The WARNING mask is crossed first, so it needs to be the stricter of
+	// the two masks.
+	if warningMask&^criticalMask != 0 {
+		return fmt.Errorf(
+			tmplWarningNotSubsetOfCriticalMsg,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+			warningMask,
+			nagios.StateCRITICALLabel,
+			ths.Description,
+			criticalMask,
+		)
+	}
+
+	if warningMask == criticalMask {
+		return fmt.Errorf(
+			tmplWarningEqualToCriticalErrMsg,
+			nagios.StateWARNINGLabel,
+			ths.Description,
+			warningMask,
+			nagios.StateCRITICALLabel,
+			ths.Description,
+			criticalMask,
+		)
+	}
+
+	return nil
+
+}
+
 // validate verifies that user-provided and/or default values are acceptable.
 //
 // Where possible/reliable, getter methods are checked instead of directly
@@ -182,6 +780,89 @@ func (c Config) validate() error {
 	// TODO: Search.PathsExclude - how to handle this one? The file or
 	// directory not existing should not be treated as a problem.
 
+	if c.Search.MaxDepth != nil {
+		if !c.Recursive() {
+			return fmt.Errorf("'max-depth' specified without 'recurse'")
+		}
+
+		if c.MaxDepth() < 1 {
+			return fmt.Errorf(
+				"invalid max-depth value %d provided; minimum of 1",
+				c.MaxDepth(),
+			)
+		}
+	}
+
+	if c.MinDepth() < 0 {
+		return fmt.Errorf(
+			"invalid min-depth value %d provided; minimum of 0",
+			c.MinDepth(),
+		)
+	}
+
+	// without recursion no content deeper than depth 1 is evaluated
+	if c.MinDepth() > 1 && !c.Recursive() {
+		return fmt.Errorf(
+			"min-depth value %d specified without 'recurse'; maximum of 1",
+			c.MinDepth(),
+		)
+	}
+
+	if c.Search.MaxDepth != nil && c.MinDepth() > c.MaxDepth() {
+		return fmt.Errorf(
+			"provided min-depth (%d) greater than max-depth (%d)",
+			c.MinDepth(),
+			c.MaxDepth(),
+		)
+	}
+
+	if c.Search.OneFileSystem != nil && c.OneFileSystem() {
+		if !c.Recursive() {
+			return fmt.Errorf("'one-file-system' specified without 'recurse'")
+		}
+
+		if osWindows {
+			return fmt.Errorf(
+				"'one-file-system' specified; not currently supported for Windows",
+			)
+		}
+	}
+
+	if skipFSTypes := c.SkipFSTypes(); len(skipFSTypes) > 0 {
+		if !c.Recursive() {
+			return fmt.Errorf("'skip-fstype' specified without 'recurse'")
+		}
+
+		if osWindows {
+			return fmt.Errorf(
+				"'skip-fstype' specified; not currently supported for Windows",
+			)
+		}
+
+		for _, fsType := range skipFSTypes {
+			if err := mountValueValidation(fsType); err != nil {
+				return fmt.Errorf(
+					"invalid value %q specified for skip-fstype: %w",
+					fsType,
+					err,
+				)
+			}
+		}
+	}
+
+	if c.PatternRegex() && len(c.IncludePatterns()) == 0 && len(c.ExcludePatterns()) == 0 {
+		return fmt.Errorf(
+			"'pattern-regex' specified without " +
+				"'include-pattern' or 'exclude-pattern'",
+		)
+	}
+
+	for _, pattern := range append(c.IncludePatterns(), c.ExcludePatterns()...) {
+		if err := patternValidation(pattern, c.PatternRegex()); err != nil {
+			return err
+		}
+	}
+
 	switch c.LogLevel() {
 	case LogLevelDisabled:
 	case LogLevelPanic:
@@ -209,6 +890,12 @@ func (c Config) validate() error {
 	ageCriticalSet := c.Search.AgeCritical != nil
 	ageWarningSet := c.Search.AgeWarning != nil
 
+	ageMinCriticalSet := c.Search.AgeMinCritical != nil
+	ageMinWarningSet := c.Search.AgeMinWarning != nil
+
+	ageNewestCriticalSet := c.Search.AgeNewestCritical != nil
+	ageNewestWarningSet := c.Search.AgeNewestWarning != nil
+
 	sizeMaxCriticalSet := c.Search.SizeMaxCritical != nil
 	sizeMaxWarningSet := c.Search.SizeMaxWarning != nil
 	sizeMaxSet := c.Search.SizeMaxCritical != nil && c.Search.SizeMaxWarning != nil
@@ -217,11 +904,64 @@ func (c Config) validate() error {
 	sizeMinWarningSet := c.Search.SizeMinWarning != nil
 	sizeMinSet := c.Search.SizeMinCritical != nil && c.Search.SizeMinWarning != nil
 
-	usernameMissingCriticalSet := c.Search.UsernameMissingCritical != nil
-	usernameMissingWarningSet := c.Search.UsernameMissingWarning != nil
+	fileSizeMaxCriticalSet := c.Search.FileSizeMaxCritical != nil
+	fileSizeMaxWarningSet := c.Search.FileSizeMaxWarning != nil
+	fileSizeMaxSet := c.Search.FileSizeMaxCritical != nil && c.Search.FileSizeMaxWarning != nil
+
+	maxLinksCriticalSet := c.Search.MaxLinksCritical != nil
+	maxLinksWarningSet := c.Search.MaxLinksWarning != nil
+	maxLinksSet := c.Search.MaxLinksCritical != nil && c.Search.MaxLinksWarning != nil
+
+	countMaxCriticalSet := c.Search.CountMaxCritical != nil
+	countMaxWarningSet := c.Search.CountMaxWarning != nil
+	countMaxSet := c.Search.CountMaxCritical != nil && c.Search.CountMaxWarning != nil
+
+	countMinCriticalSet := c.Search.CountMinCritical != nil
+	countMinWarningSet := c.Search.CountMinWarning != nil
+	countMinSet := c.Search.CountMinCritical != nil && c.Search.CountMinWarning != nil
+
+	usernameMissingCriticalSet := c.Search.UsernameMissingCritical != nil
+	usernameMissingWarningSet := c.Search.UsernameMissingWarning != nil
+
+	groupNameMissingCriticalSet := c.Search.GroupNameMissingCritical != nil
+	groupNameMissingWarningSet := c.Search.GroupNameMissingWarning != nil
+
+	orphanedOwners := c.OrphanedOwners()
+
+	ownerGroupMemberCriticalSet := c.Search.OwnerGroupMemberCritical != nil
+	ownerGroupMemberWarningSet := c.Search.OwnerGroupMemberWarning != nil
+
+	permissionsSet := c.Permissions().Set
+
+	modeMaxCriticalSet := c.Search.ModeMaxCritical != nil
+	modeMaxWarningSet := c.Search.ModeMaxWarning != nil
+	modeMaxSet := modeMaxCriticalSet && modeMaxWarningSet
+
+	dirModeMaxCriticalSet := c.Search.DirModeMaxCritical != nil
+	dirModeMaxWarningSet := c.Search.DirModeMaxWarning != nil
+	dirModeMaxSet := dirModeMaxCriticalSet && dirModeMaxWarningSet
+
+	fsFreeBytesCriticalSet := c.Search.FSFreeBytesCritical != nil
+	fsFreeBytesWarningSet := c.Search.FSFreeBytesWarning != nil
+	fsFreePercentCriticalSet := c.Search.FSFreePercentCritical != nil
+	fsFreePercentWarningSet := c.Search.FSFreePercentWarning != nil
+	fsInodesFreePercentCriticalSet := c.Search.FSInodesFreePercentCritical != nil
+	fsInodesFreePercentWarningSet := c.Search.FSInodesFreePercentWarning != nil
+	filesystemSet := c.Filesystem().Any()
+
+	mountRequirements := c.Mount()
+
+	securityAudit := c.SecurityAudit()
+
+	emptiness := c.Emptiness()
+
+	fileTypes := c.FileTypes()
+
+	danglingSymlinks := c.DanglingSymlinks()
+
+	symlinkTarget := c.SymlinkTarget()
 
-	groupNameMissingCriticalSet := c.Search.GroupNameMissingCritical != nil
-	groupNameMissingWarningSet := c.Search.GroupNameMissingWarning != nil
+	xattrs := c.Xattrs()
 
 	// Needs to be maintained to list all potential conflicts.
 	// TODO: What is a better way to handle this?
@@ -230,12 +970,47 @@ func (c Config) validate() error {
 			sizeMaxWarningSet ||
 			sizeMinCriticalSet ||
 			sizeMinWarningSet ||
+			fileSizeMaxCriticalSet ||
+			fileSizeMaxWarningSet ||
+			maxLinksCriticalSet ||
+			maxLinksWarningSet ||
+			countMaxCriticalSet ||
+			countMaxWarningSet ||
+			countMinCriticalSet ||
+			countMinWarningSet ||
 			ageCriticalSet ||
 			ageWarningSet ||
+			ageMinCriticalSet ||
+			ageMinWarningSet ||
+			ageNewestCriticalSet ||
+			ageNewestWarningSet ||
 			usernameMissingCriticalSet ||
 			usernameMissingWarningSet ||
 			groupNameMissingCriticalSet ||
-			groupNameMissingWarningSet) {
+			groupNameMissingWarningSet ||
+			orphanedOwners.Check ||
+			ownerGroupMemberCriticalSet ||
+			ownerGroupMemberWarningSet ||
+			permissionsSet ||
+			modeMaxCriticalSet ||
+			modeMaxWarningSet ||
+			dirModeMaxCriticalSet ||
+			dirModeMaxWarningSet ||
+			securityAudit.SetIDCheck ||
+			securityAudit.WorldWritableDirsCheck ||
+			emptiness.EmptyCheck ||
+			emptiness.NotEmptyCheck ||
+			fileTypes.Any() ||
+			danglingSymlinks.Check ||
+			xattrs.Any() ||
+			symlinkTarget.Any() ||
+			fsFreeBytesCriticalSet ||
+			fsFreeBytesWarningSet ||
+			fsFreePercentCriticalSet ||
+			fsFreePercentWarningSet ||
+			fsInodesFreePercentCriticalSet ||
+			fsInodesFreePercentWarningSet ||
+			mountRequirements.Any()) {
 
 		if existsCriticalSet {
 			return fmt.Errorf(
@@ -256,65 +1031,79 @@ func (c Config) validate() error {
 	}
 
 	if ageCriticalSet || ageWarningSet {
-
-		notSetErrMsg :=
-			"minimum age in days not specified for %s threshold; " +
-				"both values required if checking file age"
-
-		tooSmallErrMsg :=
-			"provided age in days (%d) not valid for %s threshold"
-
-		warningGreaterThanCriticalMsg :=
-			"provided %s age in days (%d) greater than %s age in days (%d)"
-
-		warningEqualToCriticalMsg :=
-			"provided %s age in days (%d) equal to %s age in days (%d)"
-
-		if !ageCriticalSet {
-			return fmt.Errorf(notSetErrMsg, nagios.StateCRITICALLabel)
-		}
-
-		if !ageWarningSet {
-			return fmt.Errorf(notSetErrMsg, nagios.StateWARNINGLabel)
+		ageErr := pathAgeValidation(
+			c.Age(),
+			c.Search.AgeCritical,
+			c.Search.AgeWarning,
+			defaultAgeUnit,
+		)
+		if ageErr != nil {
+			return ageErr
 		}
+	}
 
-		ageThresholds := c.Age()
-
-		if ageThresholds.Critical <= 0 {
-			return fmt.Errorf(
-				tooSmallErrMsg,
-				ageThresholds.Critical,
-				nagios.StateCRITICALLabel,
-			)
+	if ageMinCriticalSet || ageMinWarningSet {
+		ageErr := pathAgeValidation(
+			c.AgeMin(),
+			c.Search.AgeMinCritical,
+			c.Search.AgeMinWarning,
+			defaultAgeUnit,
+		)
+		if ageErr != nil {
+			return ageErr
 		}
+	}
 
-		if ageThresholds.Warning <= 0 {
-			return fmt.Errorf(
-				tooSmallErrMsg,
-				ageThresholds.Warning,
-				nagios.StateWARNINGLabel,
-			)
+	if ageNewestCriticalSet || ageNewestWarningSet {
+		ageErr := pathAgeValidation(
+			c.AgeNewest(),
+			c.Search.AgeNewestCritical,
+			c.Search.AgeNewestWarning,
+			defaultAgeNewestUnit,
+		)
+		if ageErr != nil {
+			return ageErr
 		}
+	}
 
-		if ageThresholds.Warning > ageThresholds.Critical {
+	switch c.AgeTimestamp() {
+	case paths.TimestampModified:
+	case paths.TimestampChanged, paths.TimestampAccessed, paths.TimestampBirth:
+		if osWindows {
 			return fmt.Errorf(
-				warningGreaterThanCriticalMsg,
-				nagios.StateWARNINGLabel,
-				ageThresholds.Warning,
-				nagios.StateCRITICALLabel,
-				ageThresholds.Critical,
+				"age-timestamp %q specified; only %q is currently supported for Windows",
+				c.AgeTimestamp(),
+				paths.TimestampModified,
 			)
 		}
+	default:
+		return fmt.Errorf(
+			"invalid age-timestamp value %q provided; supported values: %s, %s, %s, %s",
+			c.AgeTimestamp(),
+			paths.TimestampModified,
+			paths.TimestampChanged,
+			paths.TimestampAccessed,
+			paths.TimestampBirth,
+		)
+	}
 
-		if ageThresholds.Warning == ageThresholds.Critical {
+	switch c.SizeSource() {
+	case SizeSourceApparent:
+	case SizeSourceAllocated:
+		if osWindows {
 			return fmt.Errorf(
-				warningEqualToCriticalMsg,
-				nagios.StateWARNINGLabel,
-				ageThresholds.Warning,
-				nagios.StateCRITICALLabel,
-				ageThresholds.Critical,
+				"size-source %q specified; only %q is currently supported for Windows",
+				c.SizeSource(),
+				SizeSourceApparent,
 			)
 		}
+	default:
+		return fmt.Errorf(
+			"invalid size-source value %q provided; supported values: %s, %s",
+			c.SizeSource(),
+			SizeSourceApparent,
+			SizeSourceAllocated,
+		)
 	}
 
 	if sizeMaxCriticalSet || sizeMaxWarningSet {
@@ -339,6 +1128,51 @@ func (c Config) validate() error {
 		}
 	}
 
+	if fileSizeMaxCriticalSet || fileSizeMaxWarningSet {
+		sizeErr := pathSizeValidation(
+			c.FileSizeMax(),
+			c.Search.FileSizeMaxCritical,
+			c.Search.FileSizeMaxWarning,
+		)
+		if sizeErr != nil {
+			return sizeErr
+		}
+	}
+
+	if maxLinksCriticalSet || maxLinksWarningSet {
+		if osWindows {
+			return fmt.Errorf(
+				"max-links-critical or max-links-warning specified; not currently supported for Windows",
+			)
+		}
+
+		if err := maxLinksValidation(c.Search.MaxLinksCritical, c.Search.MaxLinksWarning); err != nil {
+			return err
+		}
+	}
+
+	if countMaxCriticalSet || countMaxWarningSet {
+		countErr := pathCountValidation(
+			c.CountMax(),
+			c.Search.CountMaxCritical,
+			c.Search.CountMaxWarning,
+		)
+		if countErr != nil {
+			return countErr
+		}
+	}
+
+	if countMinCriticalSet || countMinWarningSet {
+		countErr := pathCountValidation(
+			c.CountMin(),
+			c.Search.CountMinCritical,
+			c.Search.CountMinWarning,
+		)
+		if countErr != nil {
+			return countErr
+		}
+	}
+
 	if usernameMissingCriticalSet && usernameMissingWarningSet {
 		return fmt.Errorf(
 			"username-missing-critical' and " +
@@ -420,16 +1254,450 @@ func (c Config) validate() error {
 
 	}
 
-	// if neither size (both), age (both), existence (only one), username
-	// (only one) or group name (only one) is provided, then configuration is
-	// incomplete
-	if !(sizeMinSet || sizeMaxSet) &&
+	if ownerGroupMemberCriticalSet && ownerGroupMemberWarningSet {
+		return fmt.Errorf(
+			"'owner-group-member-critical' and " +
+				"'owner-group-member-warning' specified; only one is permitted",
+		)
+	}
+
+	if ownerGroupMemberCriticalSet || ownerGroupMemberWarningSet {
+		flag := "owner-group-member-critical"
+		value := c.Search.OwnerGroupMemberCritical
+		if ownerGroupMemberWarningSet {
+			flag = "owner-group-member-warning"
+			value = c.Search.OwnerGroupMemberWarning
+		}
+
+		if osWindows {
+			return fmt.Errorf(
+				"'%s' specified; not currently supported for Windows",
+				flag,
+			)
+		}
+
+		if err := groupNameValidation(*value); err != nil {
+			return fmt.Errorf(
+				"invalid value %q specified for %s: %w",
+				*value,
+				flag,
+				err,
+			)
+		}
+
+		// group memberships are resolved using the user entry from one
+		// source and the group entries from the other; mixing the host and
+		// an alternate file would give misleading results
+		if (c.Search.PasswdFile == nil) != (c.Search.GroupFile == nil) {
+			return fmt.Errorf(
+				"'%s' specified with only one of 'passwd-file' or "+
+					"'group-file'; both are required to resolve group memberships",
+				flag,
+			)
+		}
+	}
+
+	if permissionsSet {
+		if osWindows {
+			return fmt.Errorf(
+				"permission bit assertions specified; not currently supported for Windows",
+			)
+		}
+
+		if err := permissionsValidation(c.Permissions()); err != nil {
+			return err
+		}
+	}
+
+	if modeMaxCriticalSet || modeMaxWarningSet ||
+		dirModeMaxCriticalSet || dirModeMaxWarningSet {
+		if osWindows {
+			return fmt.Errorf(
+				"maximum mode masks specified; not currently supported for Windows",
+			)
+		}
+	}
+
+	if modeMaxCriticalSet || modeMaxWarningSet {
+		modeErr := modeMaskValidation(
+			c.ModeMax().Files,
+			c.Search.ModeMaxCritical,
+			c.Search.ModeMaxWarning,
+		)
+		if modeErr != nil {
+			return modeErr
+		}
+	}
+
+	if dirModeMaxCriticalSet || dirModeMaxWarningSet {
+		modeErr := modeMaskValidation(
+			c.ModeMax().Directories,
+			c.Search.DirModeMaxCritical,
+			c.Search.DirModeMaxWarning,
+		)
+		if modeErr != nil {
+			return modeErr
+		}
+	}
+
+	if securityAudit.SetIDCritical && securityAudit.SetIDWarning {
+		return fmt.Errorf(
+			"'suid-sgid-critical' and " +
+				"'suid-sgid-warning' specified; only one is permitted",
+		)
+	}
+
+	if len(securityAudit.SetIDAllowed) > 0 && !securityAudit.SetIDCheck {
+		return fmt.Errorf(
+			"'suid-sgid-allowed' specified without " +
+				"'suid-sgid-critical' or 'suid-sgid-warning'",
+		)
+	}
+
+	// allowed paths are compared with the fully-qualified path of each
+	// evaluated file
+	for _, allowed := range securityAudit.SetIDAllowed {
+		if !filepath.IsAbs(allowed) {
+			return fmt.Errorf(
+				"invalid value %q specified for suid-sgid-allowed; absolute path required",
+				allowed,
+			)
+		}
+	}
+
+	if securityAudit.WorldWritableDirsCritical && securityAudit.WorldWritableDirsWarning {
+		return fmt.Errorf(
+			"'world-writable-dirs-critical' and " +
+				"'world-writable-dirs-warning' specified; only one is permitted",
+		)
+	}
+
+	if (securityAudit.SetIDCheck || securityAudit.WorldWritableDirsCheck) && osWindows {
+		return fmt.Errorf(
+			"setuid/setgid or world-writable directory checks specified; " +
+				"not currently supported for Windows",
+		)
+	}
+
+	if emptiness.EmptyCritical && emptiness.EmptyWarning {
+		return fmt.Errorf(
+			"'assert-empty-critical' and " +
+				"'assert-empty-warning' specified; only one is permitted",
+		)
+	}
+
+	if emptiness.NotEmptyCritical && emptiness.NotEmptyWarning {
+		return fmt.Errorf(
+			"'assert-not-empty-critical' and " +
+				"'assert-not-empty-warning' specified; only one is permitted",
+		)
+	}
+
+	if emptiness.EmptyCheck && emptiness.NotEmptyCheck {
+		return fmt.Errorf(
+			"'assert-empty' and 'assert-not-empty' checks specified; only one is permitted",
+		)
+	}
+
+	for _, fileTypeList := range []struct {
+		flag  string
+		types []string
+	}{
+		{flag: "path-type", types: fileTypes.PathTypes},
+		{flag: "file-type-allowed", types: fileTypes.Allowed},
+		{flag: "file-type-denied", types: fileTypes.Denied},
+	} {
+		for _, fileType := range fileTypeList.types {
+			if err := fileTypeValidation(fileType); err != nil {
+				return fmt.Errorf(
+					"invalid value specified for %s: %w",
+					fileTypeList.flag,
+					err,
+				)
+			}
+		}
+	}
+
+	// the specified path itself is not evaluated if a minimum depth is
+	// specified, so the path type assertion would never be applied
+	if fileTypes.PathCheck() && c.MinDepth() > 0 {
+		return fmt.Errorf(
+			"'path-type' specified with min-depth value %d; maximum of 0",
+			c.MinDepth(),
+		)
+	}
+
+	for _, fileType := range fileTypes.Allowed {
+		if textutils.InList(fileType, fileTypes.Denied) {
+			return fmt.Errorf(
+				"file type %q specified for both 'file-type-allowed' and 'file-type-denied'",
+				fileType,
+			)
+		}
+	}
+
+	if fileTypes.Critical && fileTypes.Warning {
+		return fmt.Errorf(
+			"'file-type-critical' and " +
+				"'file-type-warning' specified; only one is permitted",
+		)
+	}
+
+	if fileTypes.ContentCheck() && !(fileTypes.Critical || fileTypes.Warning) {
+		return fmt.Errorf(
+			"'file-type-allowed' or 'file-type-denied' specified without " +
+				"'file-type-critical' or 'file-type-warning'",
+		)
+	}
+
+	if (fileTypes.Critical || fileTypes.Warning) && !fileTypes.ContentCheck() {
+		return fmt.Errorf(
+			"'file-type-critical' or 'file-type-warning' specified without " +
+				"'file-type-allowed' or 'file-type-denied'",
+		)
+	}
+
+	idFiles := []struct {
+		flag  string
+		value *string
+	}{
+		{flag: "passwd-file", value: c.Search.PasswdFile},
+		{flag: "group-file", value: c.Search.GroupFile},
+	}
+	for _, idFile := range idFiles {
+		if idFile.value == nil {
+			continue
+		}
+
+		switch {
+		case osWindows:
+			return fmt.Errorf(
+				"'%s' specified; not currently supported for Windows",
+				idFile.flag,
+			)
+
+		case !filepath.IsAbs(*idFile.value):
+			return fmt.Errorf(
+				"invalid value %q specified for %s; absolute path required",
+				*idFile.value,
+				idFile.flag,
+			)
+
+		case !(usernameMissingCriticalSet || usernameMissingWarningSet ||
+			groupNameMissingCriticalSet || groupNameMissingWarningSet ||
+			ownerGroupMemberCriticalSet || ownerGroupMemberWarningSet ||
+			orphanedOwners.Check):
+			return fmt.Errorf(
+				"'%s' specified without username, group name, owner group membership or orphaned owner checks",
+				idFile.flag,
+			)
+		}
+	}
+
+	if orphanedOwners.Critical && orphanedOwners.Warning {
+		return fmt.Errorf(
+			"'orphaned-owner-critical' and " +
+				"'orphaned-owner-warning' specified; only one is permitted",
+		)
+	}
+
+	if orphanedOwners.Check && osWindows {
+		return fmt.Errorf(
+			"'orphaned-owner-critical' or 'orphaned-owner-warning' specified; " +
+				"not currently supported for Windows",
+		)
+	}
+
+	for _, xattrList := range []struct {
+		flag  string
+		specs []string
+	}{
+		{flag: "xattr-required", specs: xattrs.Required},
+		{flag: "xattr-forbidden", specs: xattrs.Forbidden},
+	} {
+		for _, spec := range xattrList.specs {
+			if err := xattrValidation(spec); err != nil {
+				return fmt.Errorf(
+					"invalid value %q specified for %s: %w",
+					spec,
+					xattrList.flag,
+					err,
+				)
+			}
+		}
+	}
+
+	if xattrs.Critical && xattrs.Warning {
+		return fmt.Errorf(
+			"'xattr-critical' and " +
+				"'xattr-warning' specified; only one is permitted",
+		)
+	}
+
+	if xattrs.Any() && !(xattrs.Critical || xattrs.Warning) {
+		return fmt.Errorf(
+			"'xattr-required' or 'xattr-forbidden' specified without " +
+				"'xattr-critical' or 'xattr-warning'",
+		)
+	}
+
+	if (xattrs.Critical || xattrs.Warning) && !xattrs.Any() {
+		return fmt.Errorf(
+			"'xattr-critical' or 'xattr-warning' specified without " +
+				"'xattr-required' or 'xattr-forbidden'",
+		)
+	}
+
+	if xattrs.Any() && osWindows {
+		return fmt.Errorf(
+			"extended attribute assertions specified; not currently supported for Windows",
+		)
+	}
+
+	if danglingSymlinks.Critical && danglingSymlinks.Warning {
+		return fmt.Errorf(
+			"'dangling-symlinks-critical' and " +
+				"'dangling-symlinks-warning' specified; only one is permitted",
+		)
+	}
+
+	if c.Search.SymlinkTarget != nil {
+		if !filepath.IsAbs(symlinkTarget.Target) {
+			return fmt.Errorf(
+				"invalid value %q specified for symlink-target; absolute path required",
+				symlinkTarget.Target,
+			)
+		}
+
+		if _, err := textutils.GlobToRegexp(symlinkTarget.Target); err != nil {
+			return fmt.Errorf(
+				"invalid value %q specified for symlink-target: %w",
+				symlinkTarget.Target,
+				err,
+			)
+		}
+	}
+
+	if c.Search.SymlinkTargetOrder != nil && !symlinkTarget.Newest {
+		return fmt.Errorf(
+			"symlink-target-order specified without symlink-target-newest",
+		)
+	}
+
+	switch symlinkTarget.Order {
+	case paths.SiblingOrderVersion:
+	case paths.SiblingOrderName:
+	case paths.SiblingOrderModified:
+	default:
+		return fmt.Errorf(
+			"invalid symlink-target-order value %q provided; supported values: %s, %s, %s",
+			symlinkTarget.Order,
+			paths.SiblingOrderVersion,
+			paths.SiblingOrderName,
+			paths.SiblingOrderModified,
+		)
+	}
+
+	if fsFreeBytesCriticalSet || fsFreeBytesWarningSet {
+		var fsCritical, fsWarning *float64
+		if fsFreeBytesCriticalSet {
+			v := float64(*c.Search.FSFreeBytesCritical)
+			fsCritical = &v
+		}
+		if fsFreeBytesWarningSet {
+			v := float64(*c.Search.FSFreeBytesWarning)
+			fsWarning = &v
+		}
+
+		if err := filesystemFreeValidation(fsFreeBytesDescription, fsCritical, fsWarning, 0); err != nil {
+			return err
+		}
+	}
+
+	if fsFreePercentCriticalSet || fsFreePercentWarningSet {
+		err := filesystemFreeValidation(
+			fsFreePercentDescription,
+			c.Search.FSFreePercentCritical,
+			c.Search.FSFreePercentWarning,
+			100,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if fsInodesFreePercentCriticalSet || fsInodesFreePercentWarningSet {
+		err := filesystemFreeValidation(
+			fsInodesFreePercentDescription,
+			c.Search.FSInodesFreePercentCritical,
+			c.Search.FSInodesFreePercentWarning,
+			100,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if filesystemSet && osWindows {
+		return fmt.Errorf(
+			"filesystem free space or free inode checks specified; " +
+				"not currently supported for Windows",
+		)
+	}
+
+	for _, fsType := range mountRequirements.FSTypes {
+		if err := mountValueValidation(fsType); err != nil {
+			return fmt.Errorf(
+				"invalid value %q specified for require-fstype: %w",
+				fsType,
+				err,
+			)
+		}
+	}
+
+	for _, option := range mountRequirements.MountOptions {
+		if err := mountValueValidation(option); err != nil {
+			return fmt.Errorf(
+				"invalid value %q specified for require-mount-option: %w",
+				option,
+				err,
+			)
+		}
+	}
+
+	if mountRequirements.Any() && osWindows {
+		return fmt.Errorf(
+			"mount point, filesystem type or mount option checks specified; " +
+				"not currently supported for Windows",
+		)
+	}
+
+	// if no check is requested (e.g., both critical and warning thresholds
+	// for age or size checks, only one of critical or warning for existence,
+	// username or group name checks), then configuration is incomplete
+	if !(sizeMinSet || sizeMaxSet || fileSizeMaxSet) &&
+		!maxLinksSet &&
+		!(countMinSet || countMaxSet) &&
 		!(ageCriticalSet && ageWarningSet) &&
+		!(ageMinCriticalSet && ageMinWarningSet) &&
+		!(ageNewestCriticalSet && ageNewestWarningSet) &&
 		!(existsCriticalSet || existsWarningSet) &&
 		!(usernameMissingCriticalSet || usernameMissingWarningSet) &&
-		!(groupNameMissingCriticalSet || groupNameMissingWarningSet) {
+		!(groupNameMissingCriticalSet || groupNameMissingWarningSet) &&
+		!orphanedOwners.Check &&
+		!(ownerGroupMemberCriticalSet || ownerGroupMemberWarningSet) &&
+		!permissionsSet &&
+		!(modeMaxSet || dirModeMaxSet) &&
+		!(securityAudit.SetIDCheck || securityAudit.WorldWritableDirsCheck) &&
+		!(emptiness.EmptyCheck || emptiness.NotEmptyCheck) &&
+		!fileTypes.Any() &&
+		!danglingSymlinks.Check &&
+		!xattrs.Any() &&
+		!symlinkTarget.Any() &&
+		!filesystemSet &&
+		!mountRequirements.Any() {
 		return fmt.Errorf(
-			"no values specified for age, minimum size, maximum size, username, group name or existence",
+			"no values specified for age, minimum age, newest file age, minimum size, maximum size, per-file maximum size, maximum links, minimum count, maximum count, username, group name, orphaned owner, owner group membership, permissions, maximum mode, security audit, emptiness, file type, dangling symlinks, extended attributes, symlink target, filesystem free space, mount or existence",
 		)
 	}
 
@@ -0,0 +1,117 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatePathTypeMinDepth(t *testing.T) {
+	t.Parallel()
+
+	boolPtr := func(b bool) *bool { return &b }
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name    string
+		search  Search
+		wantErr bool
+	}{
+		{
+			name: "path type without min depth",
+			search: Search{
+				PathsInclude: []string{"/srv/app"},
+				PathTypes:    []string{FileTypeDirectory},
+			},
+		},
+		{
+			name: "path type with min depth of 0",
+			search: Search{
+				PathsInclude: []string{"/srv/app"},
+				PathTypes:    []string{FileTypeDirectory},
+				MinDepth:     intPtr(0),
+			},
+		},
+		{
+			name: "path type with min depth of 1",
+			search: Search{
+				PathsInclude: []string{"/srv/app"},
+				PathTypes:    []string{FileTypeDirectory},
+				MinDepth:     intPtr(1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "path type with recursive min depth",
+			search: Search{
+				PathsInclude: []string{"/srv/app"},
+				PathTypes:    []string{FileTypeDirectory},
+				Recursive:    boolPtr(true),
+				MinDepth:     intPtr(2),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := Config{Search: tt.search}
+			err := c.validate()
+
+			switch {
+			case tt.wantErr && (err == nil || !strings.Contains(err.Error(), "'path-type'")):
+				t.Errorf("got error %v; want path-type validation error", err)
+			case !tt.wantErr && err != nil:
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseModeMask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		mask    string
+		want    uint32
+		wantErr bool
+	}{
+		{mask: "0755", want: 0o755},
+		{mask: "755", want: 0o755},
+		{mask: "0o640", want: 0o640},
+		{mask: " 0644 ", want: 0o644},
+		{mask: "4755", want: 0o4755},
+		{mask: "7777", want: 0o7777},
+		{mask: "0", want: 0},
+		{mask: "17777", wantErr: true},
+		{mask: "0799", wantErr: true},
+		{mask: "rwxr-xr-x", wantErr: true},
+		{mask: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.mask, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseModeMask(tt.mask)
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("parseModeMask(%q) = %#o; want error", tt.mask, got)
+			case !tt.wantErr && err != nil:
+				t.Errorf("parseModeMask(%q) unexpected error: %v", tt.mask, err)
+			case got != tt.want:
+				t.Errorf("parseModeMask(%q) = %#o; want %#o", tt.mask, got, tt.want)
+			}
+		})
+	}
+}
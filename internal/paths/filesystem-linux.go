@@ -0,0 +1,110 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// mountInfoFile is the mountinfo file for the current process.
+const mountInfoFile string = "/proc/self/mountinfo"
+
+// Mounts returns the mount entries visible to the current process.
+func Mounts() ([]MountInfo, error) {
+	fh, err := os.Open(mountInfoFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", mountInfoFile, err)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+
+	return ParseMountInfo(fh)
+}
+
+// MountForPath returns the mount entry backing the specified path. Symlinks
+// in the path are resolved before the mount entry is determined.
+func MountForPath(path string, mounts []MountInfo) (MountInfo, error) {
+
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return MountInfo{}, err
+	}
+
+	mount, ok := FindMount(mounts, resolved)
+	if !ok {
+		return MountInfo{}, fmt.Errorf("unable to determine mount for path %s", path)
+	}
+
+	return mount, nil
+}
+
+// IsMountPoint indicates whether the specified path is itself a mount point.
+// Symlinks in the path are resolved before the mount entries are consulted.
+func IsMountPoint(path string, mounts []MountInfo) (bool, error) {
+
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, mount := range mounts {
+		if mount.MountPoint == resolved {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// FilesystemInfo returns usage details for the filesystem hosting the
+// specified path.
+func FilesystemInfo(path string, mounts []MountInfo) (FilesystemStats, error) {
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return FilesystemStats{}, fmt.Errorf("%w: %s", ErrPathDoesNotExist, path)
+		}
+		return FilesystemStats{}, fmt.Errorf("failed to stat path %s: %w", path, err)
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return FilesystemStats{}, fmt.Errorf("unable to retrieve device for path %s", path)
+	}
+
+	var statfs unix.Statfs_t
+	if err := unix.Statfs(path, &statfs); err != nil {
+		return FilesystemStats{}, fmt.Errorf("statfs call failed for path %s: %w", path, err)
+	}
+
+	mount, err := MountForPath(path, mounts)
+	if err != nil {
+		return FilesystemStats{}, err
+	}
+
+	// field types vary by architecture
+	blockSize := uint64(statfs.Bsize) //nolint:unconvert
+
+	return FilesystemStats{
+		Path:        path,
+		Device:      uint64(stat.Dev), //nolint:unconvert
+		Mount:       mount,
+		TotalBytes:  uint64(statfs.Blocks) * blockSize,
+		FreeBytes:   uint64(statfs.Bavail) * blockSize,
+		TotalInodes: uint64(statfs.Files),
+		FreeInodes:  uint64(statfs.Ffree),
+	}, nil
+}
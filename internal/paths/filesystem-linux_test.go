@@ -0,0 +1,85 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsMountPoint(t *testing.T) {
+	t.Parallel()
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mountPoint := filepath.Join(dir, "mnt")
+	if err := os.Mkdir(mountPoint, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	subdir := filepath.Join(mountPoint, "data")
+	if err := os.Mkdir(subdir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(mountPoint, link); err != nil {
+		t.Fatal(err)
+	}
+
+	mounts := []MountInfo{
+		{MountID: 1, MountPoint: "/", FSType: "ext4"},
+		{MountID: 2, ParentID: 1, MountPoint: mountPoint, FSType: "tmpfs"},
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{name: "mount point", path: mountPoint, want: true},
+		{name: "trailing separator", path: mountPoint + "/", want: true},
+		{name: "symlink to mount point", path: link, want: true},
+		{name: "directory within mount", path: subdir, want: false},
+		{name: "parent of mount", path: dir, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := IsMountPoint(tt.path, mounts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("IsMountPoint(%q) = %v; want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMountPointMissingPath(t *testing.T) {
+	t.Parallel()
+
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	_, err := IsMountPoint(missing, []MountInfo{{MountPoint: "/"}})
+	if !errors.Is(err, ErrPathDoesNotExist) {
+		t.Errorf("got error %v; want %v", err, ErrPathDoesNotExist)
+	}
+}
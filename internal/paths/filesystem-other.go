@@ -0,0 +1,39 @@
+//go:build !linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Mounts is a placeholder for operating systems where mount details are not
+// currently supported.
+func Mounts() ([]MountInfo, error) {
+	return nil, fmt.Errorf("%w: %s", ErrFilesystemUnsupported, runtime.GOOS)
+}
+
+// MountForPath is a placeholder for operating systems where mount details are
+// not currently supported.
+func MountForPath(_ string, _ []MountInfo) (MountInfo, error) {
+	return MountInfo{}, fmt.Errorf("%w: %s", ErrFilesystemUnsupported, runtime.GOOS)
+}
+
+// IsMountPoint is a placeholder for operating systems where mount details are
+// not currently supported.
+func IsMountPoint(_ string, _ []MountInfo) (bool, error) {
+	return false, fmt.Errorf("%w: %s", ErrFilesystemUnsupported, runtime.GOOS)
+}
+
+// FilesystemInfo is a placeholder for operating systems where filesystem
+// details are not currently supported.
+func FilesystemInfo(_ string, _ []MountInfo) (FilesystemStats, error) {
+	return FilesystemStats{}, fmt.Errorf("%w: %s", ErrFilesystemUnsupported, runtime.GOOS)
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrFilesystemUnsupported indicates that filesystem details are not
+// available on the current platform.
+var ErrFilesystemUnsupported = errors.New("filesystem details not supported on this platform")
+
+// MountInfo represents a single entry from the mountinfo file for a process
+// (e.g., /proc/self/mountinfo).
+type MountInfo struct {
+	MountID      int
+	ParentID     int
+	Major        uint32
+	Minor        uint32
+	Root         string
+	MountPoint   string
+	MountOptions []string
+	FSType       string
+	Source       string
+	SuperOptions []string
+}
+
+// FilesystemStats represents usage details for the filesystem hosting a
+// specified path.
+type FilesystemStats struct {
+
+	// Path is the specified path hosted by the filesystem.
+	Path string
+
+	// Device is the device ID of the filesystem (st_dev) and is used to
+	// determine whether paths are hosted by the same filesystem.
+	Device uint64
+
+	// Mount is the mount entry backing the path.
+	Mount MountInfo
+
+	// TotalBytes is the size of the filesystem in bytes.
+	TotalBytes uint64
+
+	// FreeBytes is the space in bytes available to unprivileged users.
+	FreeBytes uint64
+
+	// TotalInodes is the number of inodes on the filesystem. Some
+	// filesystems (e.g., btrfs) report 0 as they allocate inodes
+	// dynamically.
+	TotalInodes uint64
+
+	// FreeInodes is the number of free inodes on the filesystem.
+	FreeInodes uint64
+}
+
+// SpaceReported indicates whether the filesystem reports its size. Space
+// usage is not meaningful for pseudo filesystems (e.g., proc) which do not.
+func (fs FilesystemStats) SpaceReported() bool {
+	return fs.TotalBytes > 0
+}
+
+// FreePercent returns the percentage of space on the filesystem available to
+// unprivileged users.
+func (fs FilesystemStats) FreePercent() float64 {
+	if fs.TotalBytes == 0 {
+		return 0
+	}
+
+	return float64(fs.FreeBytes) / float64(fs.TotalBytes) * 100
+}
+
+// InodesReported indicates whether the filesystem reports a fixed number of
+// inodes. Inode usage is not meaningful for filesystems which do not.
+func (fs FilesystemStats) InodesReported() bool {
+	return fs.TotalInodes > 0
+}
+
+// InodesFreePercent returns the percentage of free inodes on the filesystem.
+func (fs FilesystemStats) InodesFreePercent() float64 {
+	if fs.TotalInodes == 0 {
+		return 0
+	}
+
+	return float64(fs.FreeInodes) / float64(fs.TotalInodes) * 100
+}
+
+// perMountOptions are the options which apply to an individual mount (and
+// are recorded as per-mount options in mountinfo entries) rather than to the
+// filesystem superblock shared by all mounts of the filesystem.
+var perMountOptions = []string{
+	"ro",
+	"rw",
+	"nosuid",
+	"nodev",
+	"noexec",
+	"noatime",
+	"nodiratime",
+	"relatime",
+	"nosymfollow",
+}
+
+// HasOption indicates whether the given option (e.g., ro, noexec) is set for
+// the mount. Per-mount options are only matched against the per-mount
+// options of the entry as the superblock options may differ (e.g., a
+// read-only bind mount of a filesystem mounted read-write elsewhere). Other
+// (filesystem-specific) options are matched against both.
+func (mi MountInfo) HasOption(option string) bool {
+	if slices.Contains(mi.MountOptions, option) {
+		return true
+	}
+
+	if slices.Contains(perMountOptions, option) {
+		return false
+	}
+
+	return slices.Contains(mi.SuperOptions, option)
+}
+
+// ParseMountInfo parses mountinfo content in the format documented in the
+// proc(5) man page.
+func ParseMountInfo(r io.Reader) ([]MountInfo, error) {
+
+	var mounts []MountInfo
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		// Optional fields are terminated by a single hyphen.
+		sep := -1
+		for i := 6; i < len(fields); i++ {
+			if fields[i] == "-" {
+				sep = i
+				break
+			}
+		}
+
+		if len(fields) < 7 || sep < 0 || len(fields) < sep+3 {
+			return nil, fmt.Errorf("invalid mountinfo entry: %q", line)
+		}
+
+		mountID, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid mount ID in mountinfo entry %q: %w", line, err)
+		}
+
+		parentID, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent ID in mountinfo entry %q: %w", line, err)
+		}
+
+		majorStr, minorStr, ok := strings.Cut(fields[2], ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid device in mountinfo entry: %q", line)
+		}
+
+		major, err := strconv.ParseUint(majorStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid device in mountinfo entry %q: %w", line, err)
+		}
+
+		minor, err := strconv.ParseUint(minorStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid device in mountinfo entry %q: %w", line, err)
+		}
+
+		mi := MountInfo{
+			MountID:      mountID,
+			ParentID:     parentID,
+			Major:        uint32(major),
+			Minor:        uint32(minor),
+			Root:         unescapeMountInfo(fields[3]),
+			MountPoint:   unescapeMountInfo(fields[4]),
+			MountOptions: strings.Split(fields[5], ","),
+			FSType:       fields[sep+1],
+			Source:       unescapeMountInfo(fields[sep+2]),
+		}
+
+		if len(fields) > sep+3 {
+			mi.SuperOptions = strings.Split(fields[sep+3], ",")
+		}
+
+		mounts = append(mounts, mi)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read mountinfo: %w", err)
+	}
+
+	return mounts, nil
+}
+
+// FindMount returns the mount entry backing the given resolved,
+// fully-qualified path. The entry with the longest mount point containing
+// the path is selected; if multiple entries share the same mount point, the
+// last entry (the most recent mount) is selected.
+func FindMount(mounts []MountInfo, path string) (MountInfo, bool) {
+
+	var found MountInfo
+	var matched bool
+
+	for _, mount := range mounts {
+		if !pathWithin(path, mount.MountPoint) {
+			continue
+		}
+
+		if !matched || len(mount.MountPoint) >= len(found.MountPoint) {
+			found = mount
+			matched = true
+		}
+	}
+
+	return found, matched
+}
+
+// pathWithin indicates whether path is the same as or is located within dir.
+func pathWithin(path string, dir string) bool {
+	switch {
+	case path == dir:
+		return true
+	case dir == "/":
+		return strings.HasPrefix(path, "/")
+	default:
+		return strings.HasPrefix(path, dir+"/")
+	}
+}
+
+// unescapeMountInfo replaces the octal escape sequences used in mountinfo
+// fields (e.g., \040 for a space) with the original characters.
+func unescapeMountInfo(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+3 < len(field) {
+			if v, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(field[i])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"strings"
+	"testing"
+)
+
+const testMountInfo = `22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw,errors=remount-ro
+23 22 0:21 / /proc rw,nosuid,nodev,noexec,relatime shared:12 - proc proc rw
+35 22 8:17 / /srv rw,relatime shared:20 - xfs /dev/sdb1 rw,attr2,inode64
+41 35 0:45 / /srv/shared\040data ro,nosuid,nodev,noexec,relatime shared:25 - nfs4 fileserver:/export/data rw,vers=4.2
+42 35 0:46 / /srv/backup rw,relatime - cifs //nas/backup rw,vers=3.0
+43 35 8:17 /releases /srv/current ro,relatime shared:20 - xfs /dev/sdb1 rw,attr2,inode64
+`
+
+func TestParseMountInfo(t *testing.T) {
+	t.Parallel()
+
+	mounts, err := ParseMountInfo(strings.NewReader(testMountInfo))
+	if err != nil {
+		t.Fatalf("unexpected error parsing mountinfo: %v", err)
+	}
+
+	if len(mounts) != 6 {
+		t.Fatalf("parsed %d mount entries; want 6", len(mounts))
+	}
+
+	nfs := mounts[3]
+	switch {
+	case nfs.MountPoint != "/srv/shared data":
+		t.Errorf("mount point = %q; want %q", nfs.MountPoint, "/srv/shared data")
+	case nfs.FSType != "nfs4":
+		t.Errorf("fstype = %q; want %q", nfs.FSType, "nfs4")
+	case nfs.Source != "fileserver:/export/data":
+		t.Errorf("source = %q; want %q", nfs.Source, "fileserver:/export/data")
+	case nfs.Major != 0 || nfs.Minor != 45:
+		t.Errorf("device = %d:%d; want 0:45", nfs.Major, nfs.Minor)
+	case !nfs.HasOption("ro") || !nfs.HasOption("noexec"):
+		t.Errorf("expected ro and noexec options; got %v", nfs.MountOptions)
+	case !nfs.HasOption("vers=4.2"):
+		t.Errorf("expected vers=4.2 superblock option; got %v", nfs.SuperOptions)
+	}
+
+	// entry without optional fields
+	if mounts[4].FSType != "cifs" || mounts[4].Source != "//nas/backup" {
+		t.Errorf("unexpected entry parsed: %+v", mounts[4])
+	}
+}
+
+func TestParseMountInfoInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseMountInfo(strings.NewReader("22 1 8:1 / / rw shared:1 ext4 /dev/sda1 rw\n")); err == nil {
+		t.Error("expected error for entry without optional fields separator")
+	}
+}
+
+func TestFindMount(t *testing.T) {
+	t.Parallel()
+
+	mounts, err := ParseMountInfo(strings.NewReader(testMountInfo))
+	if err != nil {
+		t.Fatalf("unexpected error parsing mountinfo: %v", err)
+	}
+
+	tests := map[string]string{
+		"/":                         "/",
+		"/etc/passwd":               "/",
+		"/srv":                      "/srv",
+		"/srv/app":                  "/srv",
+		"/srv/shared data/file.txt": "/srv/shared data",
+		"/srv/backupx":              "/srv",
+		"/srv/backup/nightly.tar":   "/srv/backup",
+	}
+
+	for path, want := range tests {
+		mount, ok := FindMount(mounts, path)
+		if !ok {
+			t.Errorf("no mount found for %q", path)
+			continue
+		}
+
+		if mount.MountPoint != want {
+			t.Errorf("FindMount(%q) = %q; want %q", path, mount.MountPoint, want)
+		}
+	}
+}
+
+func TestMountInfoHasOption(t *testing.T) {
+	t.Parallel()
+
+	mounts, err := ParseMountInfo(strings.NewReader(testMountInfo))
+	if err != nil {
+		t.Fatalf("unexpected error parsing mountinfo: %v", err)
+	}
+
+	root, nfs, bind := mounts[0], mounts[3], mounts[5]
+
+	tests := []struct {
+		name   string
+		mount  MountInfo
+		option string
+		want   bool
+	}{
+		{name: "per-mount option", mount: root, option: "relatime", want: true},
+		{name: "missing per-mount option", mount: root, option: "noexec", want: false},
+		{name: "superblock option", mount: root, option: "errors=remount-ro", want: true},
+		{name: "read-only mount of read-write filesystem is not rw", mount: nfs, option: "rw", want: false},
+		{name: "read-only mount of read-write filesystem is ro", mount: nfs, option: "ro", want: true},
+		{name: "filesystem-specific option", mount: nfs, option: "vers=4.2", want: true},
+		{name: "read-only bind mount is not rw", mount: bind, option: "rw", want: false},
+		{name: "read-only bind mount is ro", mount: bind, option: "ro", want: true},
+		{name: "bind mount superblock option", mount: bind, option: "inode64", want: true},
+		{name: "unknown option", mount: bind, option: "noquota", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.mount.HasOption(tt.option); got != tt.want {
+				t.Errorf("HasOption(%q) = %v; want %v", tt.option, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import "os"
+
+// Supported file types for file type assertions.
+const (
+	FileTypeRegular     = "file"
+	FileTypeDirectory   = "dir"
+	FileTypeSymlink     = "symlink"
+	FileTypeSocket      = "socket"
+	FileTypeNamedPipe   = "fifo"
+	FileTypeBlockDevice = "block-device"
+	FileTypeCharDevice  = "char-device"
+	FileTypeIrregular   = "irregular"
+)
+
+// FileType returns the type of the MetaRecord (e.g., file, dir, symlink) as
+// determined by the type bits of its mode. Symlinks are reported as such
+// unless they were followed when the MetaRecord was collected.
+func (mr MetaRecord) FileType() string {
+	return fileTypeFromMode(mr.Mode())
+}
+
+// fileTypeFromMode returns the file type name for the given mode.
+func fileTypeFromMode(mode os.FileMode) string {
+	switch modeType := mode.Type(); {
+	case modeType == 0:
+		return FileTypeRegular
+	case modeType&os.ModeDir != 0:
+		return FileTypeDirectory
+	case modeType&os.ModeSymlink != 0:
+		return FileTypeSymlink
+	case modeType&os.ModeSocket != 0:
+		return FileTypeSocket
+	case modeType&os.ModeNamedPipe != 0:
+		return FileTypeNamedPipe
+	case modeType&os.ModeCharDevice != 0:
+		return FileTypeCharDevice
+	case modeType&os.ModeDevice != 0:
+		return FileTypeBlockDevice
+	default:
+		return FileTypeIrregular
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/atc0005/check-path/internal/textutils"
+)
+
+// PathFilter applies include and exclude patterns to entries found while
+// walking a path. Patterns are matched against both the basename of an entry
+// and the path of the entry relative to the root of the walked path.
+type PathFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewPathFilter compiles the given include and exclude patterns into a
+// PathFilter. Patterns are treated as shell glob patterns (with support for
+// `**`) unless useRegex is true, in which case they are treated as
+// (unanchored) regular expressions.
+func NewPathFilter(include []string, exclude []string, useRegex bool) (PathFilter, error) {
+
+	compile := func(patterns []string) ([]*regexp.Regexp, error) {
+		compiled := make([]*regexp.Regexp, 0, len(patterns))
+		for _, pattern := range patterns {
+			var re *regexp.Regexp
+			var err error
+
+			switch {
+			case useRegex:
+				re, err = regexp.Compile(pattern)
+			default:
+				re, err = textutils.GlobToRegexp(pattern)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile pattern %q: %w", pattern, err)
+			}
+
+			compiled = append(compiled, re)
+		}
+
+		return compiled, nil
+	}
+
+	includeRe, err := compile(include)
+	if err != nil {
+		return PathFilter{}, err
+	}
+
+	excludeRe, err := compile(exclude)
+	if err != nil {
+		return PathFilter{}, err
+	}
+
+	return PathFilter{
+		include: includeRe,
+		exclude: excludeRe,
+	}, nil
+}
+
+// Included indicates whether the given path (relative to the walked root
+// and using forward slashes) matches an include pattern. If no include
+// patterns were provided, all paths are included.
+func (pf PathFilter) Included(relPath string) bool {
+	if len(pf.include) == 0 {
+		return true
+	}
+
+	return matchAny(pf.include, relPath)
+}
+
+// Excluded indicates whether the given path (relative to the walked root and
+// using forward slashes) matches an exclude pattern.
+func (pf PathFilter) Excluded(relPath string) bool {
+	return matchAny(pf.exclude, relPath)
+}
+
+// matchAny indicates whether the basename or full relative path matches any
+// of the given patterns.
+func matchAny(patterns []*regexp.Regexp, relPath string) bool {
+	base := path.Base(relPath)
+	for _, re := range patterns {
+		if re.MatchString(base) || re.MatchString(relPath) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import "sync"
+
+// IDCacheStats records the number of uid and gid to name lookups served
+// from the lookup cache (hits) and the number which required resolution
+// (misses).
+type IDCacheStats struct {
+	UserHits    int
+	UserMisses  int
+	GroupHits   int
+	GroupMisses int
+}
+
+// idCacheEntry is the cached result of resolving a uid or gid value.
+type idCacheEntry[T any] struct {
+	value T
+	known bool
+}
+
+// idCache caches the results of resolving uid or gid values (e.g., to user
+// or group names). Failed lookups are not cached.
+type idCache[T any] struct {
+	mu      sync.Mutex
+	entries map[int]idCacheEntry[T]
+	hits    int
+	misses  int
+}
+
+// Caches shared by all uid and gid lookups performed by this application.
+var (
+	userCache       idCache[string]
+	groupCache      idCache[string]
+	membershipCache idCache[[]int]
+)
+
+// lookup returns the cached value for the given ID, using the resolve
+// function to resolve and cache the value if not already cached.
+func (c *idCache[T]) lookup(id int, resolve func(int) (T, bool, error)) (T, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[id]; ok {
+		c.hits++
+		return entry.value, entry.known, nil
+	}
+
+	c.misses++
+
+	value, known, err := resolve(id)
+	if err != nil {
+		var zero T
+		return zero, false, err
+	}
+
+	if c.entries == nil {
+		c.entries = make(map[int]idCacheEntry[T])
+	}
+	c.entries[id] = idCacheEntry[T]{value: value, known: known}
+
+	return value, known, nil
+}
+
+// reset discards all cached entries.
+func (c *idCache[T]) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = nil
+}
+
+// stats returns the number of cache hits and misses.
+func (c *idCache[T]) stats() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses
+}
+
+// CacheStats returns the uid and gid lookup cache statistics for all
+// lookups performed so far.
+func CacheStats() IDCacheStats {
+	var stats IDCacheStats
+
+	stats.UserHits, stats.UserMisses = userCache.stats()
+	stats.GroupHits, stats.GroupMisses = groupCache.stats()
+
+	return stats
+}
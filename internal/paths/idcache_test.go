@@ -0,0 +1,59 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIDCacheLookup(t *testing.T) {
+	t.Parallel()
+
+	var cache idCache[string]
+	var resolved int
+
+	resolve := func(id int) (string, bool, error) {
+		resolved++
+		switch id {
+		case 0:
+			return "root", true, nil
+		case 99:
+			return "", false, errors.New("lookup failed")
+		default:
+			return "", false, nil
+		}
+	}
+
+	for _, id := range []int{0, 0, 4242, 0, 4242} {
+		if _, _, err := cache.lookup(id, resolve); err != nil {
+			t.Fatalf("unexpected error for id %d: %v", id, err)
+		}
+	}
+
+	name, known, _ := cache.lookup(4242, resolve)
+	if name != "" || known {
+		t.Errorf("got (%q, %v) for unknown id; want (\"\", false)", name, known)
+	}
+
+	// failed lookups are not cached
+	for i := 0; i < 2; i++ {
+		if _, _, err := cache.lookup(99, resolve); err == nil {
+			t.Fatal("expected error for failed lookup")
+		}
+	}
+
+	hits, misses := cache.stats()
+	if hits != 4 || misses != 4 {
+		t.Errorf("got %d hits, %d misses; want 4 hits, 4 misses", hits, misses)
+	}
+
+	if resolved != 4 {
+		t.Errorf("resolve called %d times; want 4", resolved)
+	}
+}
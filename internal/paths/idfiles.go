@@ -0,0 +1,187 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrIDFileInvalid indicates that an entry in a passwd or group file could
+// not be parsed.
+var ErrIDFileInvalid = errors.New("invalid passwd or group file entry")
+
+// ErrIDFilesIncomplete indicates that group memberships were requested when
+// only one of the alternate passwd and group files was loaded.
+var ErrIDFilesIncomplete = errors.New(
+	"alternate passwd and group files are both required to resolve group memberships",
+)
+
+// idFileEntry is an entry parsed from a passwd or group file.
+type idFileEntry struct {
+	// Name is the username or group name.
+	Name string
+
+	// Fields are all colon-separated fields of the entry, including the
+	// name and ID fields.
+	Fields []string
+}
+
+// field returns the field at the given (zero-based) index or an empty
+// string if the entry does not have the field.
+func (e idFileEntry) field(index int) string {
+	if index >= len(e.Fields) {
+		return ""
+	}
+
+	return e.Fields[index]
+}
+
+// Entries parsed from alternate passwd and group files. If nil, user and
+// group names are resolved using the os/user package.
+var (
+	passwdEntries map[int]idFileEntry
+	groupEntries  map[int]idFileEntry
+)
+
+// LoadIDFiles parses the specified passwd and group files (e.g., those of a
+// container or chroot filesystem) and uses the entries from those files
+// instead of the os/user package when resolving uid and gid values to user
+// and group names. An empty file path retains the use of the os/user package
+// for the associated ID type.
+func LoadIDFiles(passwdFile string, groupFile string) error {
+
+	if passwdFile != "" {
+		entries, err := parseIDFile(passwdFile)
+		if err != nil {
+			return fmt.Errorf("failed to load passwd file: %w", err)
+		}
+		passwdEntries = entries
+		userCache.reset()
+		membershipCache.reset()
+	}
+
+	if groupFile != "" {
+		entries, err := parseIDFile(groupFile)
+		if err != nil {
+			return fmt.Errorf("failed to load group file: %w", err)
+		}
+		groupEntries = entries
+		groupCache.reset()
+		membershipCache.reset()
+	}
+
+	return nil
+}
+
+// parseIDFile opens and parses the specified passwd or group file.
+func parseIDFile(filename string) (map[int]idFileEntry, error) {
+
+	fh, err := os.Open(filepath.Clean(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = fh.Close()
+	}()
+
+	entries, err := parseIDEntries(fh)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	return entries, nil
+}
+
+// parseIDEntries parses passwd(5) or group(5) formatted content, returning a
+// map of ID values to entries. Both formats record the name in the first field
+// and the uid or gid in the third field. Blank lines, comments and NIS
+// compatibility entries (lines beginning with + or -) are skipped. If an ID
+// is listed more than once, the first entry is used.
+func parseIDEntries(r io.Reader) (map[int]idFileEntry, error) {
+
+	entries := make(map[int]idFileEntry)
+
+	scanner := bufio.NewScanner(r)
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") ||
+			strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		fields := strings.Split(line, ":")
+		if len(fields) < 3 || fields[0] == "" {
+			return nil, fmt.Errorf("%w: line %d", ErrIDFileInvalid, lineNum)
+		}
+
+		id, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w: line %d: invalid ID %q",
+				ErrIDFileInvalid,
+				lineNum,
+				fields[2],
+			)
+		}
+
+		if _, exists := entries[int(id)]; !exists {
+			entries[int(id)] = idFileEntry{Name: fields[0], Fields: fields}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// idForName returns the lowest ID associated with the given name in the
+// parsed entries. The returned bool indicates whether the name was found.
+func idForName(name string, entries map[int]idFileEntry) (int, bool) {
+	var id int
+	var found bool
+
+	for entryID, entry := range entries {
+		if entry.Name == name && (!found || entryID < id) {
+			id = entryID
+			found = true
+		}
+	}
+
+	return id, found
+}
+
+// groupFileMemberships returns the sorted IDs of the given primary group and
+// of each group in the parsed group file entries which lists the given
+// username as a member. Each ID is listed once.
+func groupFileMemberships(username string, primaryGID int, entries map[int]idFileEntry) []int {
+	gids := []int{primaryGID}
+
+	for gid, entry := range entries {
+		if slices.Contains(strings.Split(entry.field(3), ","), username) {
+			gids = append(gids, gid)
+		}
+	}
+
+	slices.Sort(gids)
+
+	return slices.Compact(gids)
+}
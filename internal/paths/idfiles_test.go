@@ -0,0 +1,153 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseIDEntries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    map[int]string
+		wantErr error
+	}{
+		{
+			name: "passwd",
+			content: "root:x:0:0:root:/root:/bin/bash\n" +
+				"www-data:x:33:33:www-data:/var/www:/usr/sbin/nologin\n" +
+				"deploy:x:1001:1001::/home/deploy:/bin/sh\n",
+			want: map[int]string{0: "root", 33: "www-data", 1001: "deploy"},
+		},
+		{
+			name:    "group with members",
+			content: "root:x:0:\nadm:x:4:syslog,deploy\n",
+			want:    map[int]string{0: "root", 4: "adm"},
+		},
+		{
+			name:    "comments, blank and NIS compatibility lines skipped",
+			content: "# comment\n\n+::::::\n-baduser\nroot:x:0:0::/root:/bin/sh\n",
+			want:    map[int]string{0: "root"},
+		},
+		{
+			name:    "first entry wins for duplicate IDs",
+			content: "root:x:0:0::/root:/bin/sh\ntoor:x:0:0::/root:/bin/sh\n",
+			want:    map[int]string{0: "root"},
+		},
+		{
+			name:    "too few fields",
+			content: "root:x\n",
+			wantErr: ErrIDFileInvalid,
+		},
+		{
+			name:    "non-numeric ID",
+			content: "root:x:zero:0::/root:/bin/sh\n",
+			wantErr: ErrIDFileInvalid,
+		},
+		{
+			name:    "empty name",
+			content: ":x:0:0::/root:/bin/sh\n",
+			wantErr: ErrIDFileInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseIDEntries(strings.NewReader(tt.content))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v; want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := make(map[int]string, len(got))
+			for id, entry := range got {
+				names[id] = entry.Name
+			}
+
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("got %v; want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupFileMemberships(t *testing.T) {
+	t.Parallel()
+
+	entries, err := parseIDEntries(strings.NewReader(
+		"root:x:0:\n" +
+			"adm:x:4:syslog,deploy\n" +
+			"deploy:x:1001:deploy\n" +
+			"www-data:x:33:deployer,deploy-bot\n" +
+			"docker:x:998:ci,deploy\n" +
+			"nomembers:x:2000\n",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		username   string
+		primaryGID int
+		want       []int
+	}{
+		{
+			name:       "comma separated members with primary group listed once",
+			username:   "deploy",
+			primaryGID: 1001,
+			want:       []int{4, 998, 1001},
+		},
+		{
+			name:       "primary group without member entries",
+			username:   "deploy",
+			primaryGID: 2000,
+			want:       []int{4, 998, 1001, 2000},
+		},
+		{
+			name:       "partial name matches ignored",
+			username:   "deployer",
+			primaryGID: 1002,
+			want:       []int{33, 1002},
+		},
+		{
+			name:       "no memberships",
+			username:   "nobody",
+			primaryGID: 65534,
+			want:       []int{65534},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := groupFileMemberships(tt.username, tt.primaryGID, entries)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,48 +10,32 @@
 package paths
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/user"
+	"slices"
 	"strconv"
 	"syscall"
 )
 
 // ResolveIDs accepts a MetaRecord pointer and if supported, resolves the uid
 // and gid values from the underlying syscall.Stat_t and sets the values
-// directly using the provided MetaRecord pointer. For unsupported operating
-// systems, this function is effectively a NOOP.
+// directly using the provided MetaRecord pointer. uid and gid values without
+// a matching user or group entry are recorded as unknown instead of
+// returning an error. For unsupported operating systems, this function is
+// effectively a NOOP.
 func ResolveIDs(mr *MetaRecord) error {
 
 	if mr == nil {
 		return fmt.Errorf("received nil MetaRecord pointer")
 	}
 
-	// FIXME: Refactor so that we can call LookupIDs instead of duplicating the
-	// logic below?
-	stat, statOK := mr.Sys().(*syscall.Stat_t)
-	if !statOK {
-		return fmt.Errorf("failed to access syscall.Stat_t")
-	}
-
-	mr.UID = int(stat.Uid)
-	mr.GID = int(stat.Gid)
-
-	mr.GIDStr = strconv.Itoa(mr.GID)
-	var groupLookupErr error
-	groupResult, groupLookupErr := user.LookupGroupId(mr.GIDStr)
-	if groupLookupErr != nil {
-		return fmt.Errorf("failed to resolve gid to group name: %w", groupLookupErr)
-	}
-	mr.GroupName = groupResult.Name
-
-	mr.UIDStr = strconv.Itoa(mr.UID)
-	var userLookupErr error
-	userResult, userLookupErr := user.LookupId(mr.UIDStr)
-	if userLookupErr != nil {
-		return fmt.Errorf("failed to resolve uid to username: %w", userLookupErr)
+	id, err := LookupIDs(mr.FileInfo)
+	if err != nil {
+		return err
 	}
-	mr.Username = userResult.Username
+	mr.ID = id
 
 	return nil
 
@@ -59,7 +43,10 @@ func ResolveIDs(mr *MetaRecord) error {
 
 // LookupIDs accepts a os.FileInfo and if supported, resolves the uid and gid
 // values from the underlying syscall.Stat_t and returns those resolved values
-// as an instance of the ID type for further processing. For unsupported
+// as an instance of the ID type for further processing. uid and gid values
+// without a matching user or group entry are recorded as unknown instead of
+// returning an error. Alternate passwd and group files loaded via
+// LoadIDFiles are used in place of the os/user package. For unsupported
 // operating systems, this function is effectively a NOOP.
 func LookupIDs(fi os.FileInfo) (ID, error) {
 	stat, statOK := fi.Sys().(*syscall.Stat_t)
@@ -73,19 +60,219 @@ func LookupIDs(fi os.FileInfo) (ID, error) {
 	id.GID = int(stat.Gid)
 
 	id.GIDStr = strconv.Itoa(id.GID)
-	groupResult, err := user.LookupGroupId(id.GIDStr)
+	groupName, groupKnown, err := lookupGroupName(id.GID)
 	if err != nil {
 		return ID{}, fmt.Errorf("failed to resolve gid to group name: %w", err)
 	}
-	id.GroupName = groupResult.Name
+	id.GroupName = groupName
+	id.UnknownGroup = !groupKnown
 
 	id.UIDStr = strconv.Itoa(id.UID)
-	userResult, err := user.LookupId(id.UIDStr)
+	username, userKnown, err := lookupUsername(id.UID)
 	if err != nil {
 		return ID{}, fmt.Errorf("failed to resolve uid to username: %w", err)
 	}
-	id.Username = userResult.Username
+	id.Username = username
+	id.UnknownUser = !userKnown
 
 	return id, nil
 
 }
+
+// OwnerIDs accepts a os.FileInfo and if supported, returns the uid and gid
+// values from the underlying syscall.Stat_t without resolving them to user
+// and group names. For unsupported operating systems, this function returns
+// an error.
+func OwnerIDs(fi os.FileInfo) (int, int, error) {
+	stat, statOK := fi.Sys().(*syscall.Stat_t)
+	if !statOK {
+		return 0, 0, fmt.Errorf("failed to access syscall.Stat_t")
+	}
+
+	return int(stat.Uid), int(stat.Gid), nil
+}
+
+// LookupUserID resolves the given username to a uid using the entries
+// loaded from an alternate passwd file (if any) or the os/user package. The
+// returned bool indicates whether a matching user entry was found.
+func LookupUserID(username string) (int, bool, error) {
+	if passwdEntries != nil {
+		uid, found := idForName(username, passwdEntries)
+		return uid, found, nil
+	}
+
+	userResult, err := user.Lookup(username)
+	var unknownUserErr user.UnknownUserError
+	switch {
+	case errors.As(err, &unknownUserErr):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf("failed to resolve username to uid: %w", err)
+	}
+
+	uid, err := strconv.Atoi(userResult.Uid)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse uid for username %s: %w", username, err)
+	}
+
+	return uid, true, nil
+}
+
+// LookupGroupID resolves the given group name to a gid using the entries
+// loaded from an alternate group file (if any) or the os/user package. The
+// returned bool indicates whether a matching group entry was found.
+func LookupGroupID(groupName string) (int, bool, error) {
+	if groupEntries != nil {
+		gid, found := idForName(groupName, groupEntries)
+		return gid, found, nil
+	}
+
+	groupResult, err := user.LookupGroup(groupName)
+	var unknownGroupErr user.UnknownGroupError
+	switch {
+	case errors.As(err, &unknownGroupErr):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf("failed to resolve group name to gid: %w", err)
+	}
+
+	gid, err := strconv.Atoi(groupResult.Gid)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse gid for group name %s: %w", groupName, err)
+	}
+
+	return gid, true, nil
+}
+
+// lookupUsername resolves the given uid to a username, using previously
+// cached results where available. The returned bool indicates whether a
+// matching user entry was found.
+func lookupUsername(uid int) (string, bool, error) {
+	return userCache.lookup(uid, resolveUsername)
+}
+
+// lookupGroupName resolves the given gid to a group name, using previously
+// cached results where available. The returned bool indicates whether a
+// matching group entry was found.
+func lookupGroupName(gid int) (string, bool, error) {
+	return groupCache.lookup(gid, resolveGroupName)
+}
+
+// resolveUsername resolves the given uid to a username using the entries
+// loaded from an alternate passwd file (if any) or the os/user package. The
+// returned bool indicates whether a matching user entry was found.
+func resolveUsername(uid int) (string, bool, error) {
+	if passwdEntries != nil {
+		entry, ok := passwdEntries[uid]
+		return entry.Name, ok, nil
+	}
+
+	userResult, err := user.LookupId(strconv.Itoa(uid))
+	var unknownUserErr user.UnknownUserIdError
+	switch {
+	case errors.As(err, &unknownUserErr):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	}
+
+	return userResult.Username, true, nil
+}
+
+// resolveGroupName resolves the given gid to a group name using the entries
+// loaded from an alternate group file (if any) or the os/user package. The
+// returned bool indicates whether a matching group entry was found.
+func resolveGroupName(gid int) (string, bool, error) {
+	if groupEntries != nil {
+		entry, ok := groupEntries[gid]
+		return entry.Name, ok, nil
+	}
+
+	groupResult, err := user.LookupGroupId(strconv.Itoa(gid))
+	var unknownGroupErr user.UnknownGroupIdError
+	switch {
+	case errors.As(err, &unknownGroupErr):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	}
+
+	return groupResult.Name, true, nil
+}
+
+// GroupMemberships resolves the primary and supplementary group IDs of the
+// user with the given uid, using previously cached results where available.
+// The returned bool indicates whether a matching user entry was found.
+func GroupMemberships(uid int) ([]int, bool, error) {
+	return membershipCache.lookup(uid, resolveGroupMemberships)
+}
+
+// resolveGroupMemberships resolves the primary and supplementary group IDs
+// of the user with the given uid using the entries loaded from alternate
+// passwd and group files (if any) or the os/user package. Both alternate
+// files are required to resolve group memberships from them; an error is
+// returned if only one was loaded. The returned bool indicates whether a
+// matching user entry was found.
+func resolveGroupMemberships(uid int) ([]int, bool, error) {
+
+	if (passwdEntries == nil) != (groupEntries == nil) {
+		return nil, false, ErrIDFilesIncomplete
+	}
+
+	if passwdEntries != nil {
+		entry, ok := passwdEntries[uid]
+		if !ok {
+			return nil, false, nil
+		}
+
+		primaryGID, err := strconv.Atoi(entry.field(3))
+		if err != nil {
+			return nil, false, fmt.Errorf(
+				"invalid primary gid %q for user %s: %w",
+				entry.field(3),
+				entry.Name,
+				err,
+			)
+		}
+
+		return groupFileMemberships(entry.Name, primaryGID, groupEntries), true, nil
+	}
+
+	userResult, err := user.LookupId(strconv.Itoa(uid))
+	var unknownUserErr user.UnknownUserIdError
+	switch {
+	case errors.As(err, &unknownUserErr):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("failed to resolve uid to username: %w", err)
+	}
+
+	gidStrs, err := userResult.GroupIds()
+	if err != nil {
+		return nil, false, fmt.Errorf(
+			"failed to resolve group memberships for user %s: %w",
+			userResult.Username,
+			err,
+		)
+	}
+
+	gids := make([]int, 0, len(gidStrs))
+	for _, gidStr := range gidStrs {
+		gid, err := strconv.Atoi(gidStr)
+		if err != nil {
+			return nil, false, fmt.Errorf(
+				"invalid gid %q for user %s: %w",
+				gidStr,
+				userResult.Username,
+				err,
+			)
+		}
+
+		if !slices.Contains(gids, gid) {
+			gids = append(gids, gid)
+		}
+	}
+	slices.Sort(gids)
+
+	return gids, true, nil
+}
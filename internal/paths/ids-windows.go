@@ -37,3 +37,30 @@ func ResolveIDs(_ *MetaRecord) error {
 func LookupIDs(_ os.FileInfo) (ID, error) {
 	return ID{}, fmt.Errorf("LookupIDs unavailable; unsupported operating system")
 }
+
+// OwnerIDs accepts a os.FileInfo and if supported, returns the uid and gid
+// values from the underlying syscall.Stat_t without resolving them to user
+// and group names. For unsupported operating systems, this function returns
+// a hard-coded error.
+func OwnerIDs(_ os.FileInfo) (int, int, error) {
+	return 0, 0, fmt.Errorf("OwnerIDs unavailable; unsupported operating system")
+}
+
+// LookupUserID resolves the given username to a uid. For unsupported
+// operating systems, this function returns a hard-coded error.
+func LookupUserID(_ string) (int, bool, error) {
+	return 0, false, fmt.Errorf("LookupUserID unavailable; unsupported operating system")
+}
+
+// LookupGroupID resolves the given group name to a gid. For unsupported
+// operating systems, this function returns a hard-coded error.
+func LookupGroupID(_ string) (int, bool, error) {
+	return 0, false, fmt.Errorf("LookupGroupID unavailable; unsupported operating system")
+}
+
+// GroupMemberships resolves the primary and supplementary group IDs of the
+// user with the given uid. For unsupported operating systems, this function
+// returns a hard-coded error.
+func GroupMemberships(_ int) ([]int, bool, error) {
+	return nil, false, fmt.Errorf("GroupMemberships unavailable; unsupported operating system")
+}
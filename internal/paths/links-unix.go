@@ -0,0 +1,54 @@
+//go:build !windows
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"syscall"
+)
+
+// statBlockSize is the unit used by the st_blocks field of stat(2).
+const statBlockSize int64 = 512
+
+// fileIdentity returns the device and inode number for the given
+// os.FileInfo value. The returned bool indicates whether these details are
+// available.
+func fileIdentity(fi os.FileInfo) (fileID, bool) {
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fileID{}, false
+	}
+
+	// field types vary by platform
+	return fileID{dev: uint64(stat.Dev), ino: uint64(stat.Ino)}, true //nolint:unconvert
+}
+
+// allocatedSize returns the disk space in bytes allocated to the given
+// os.FileInfo value. The returned bool indicates whether the allocated size
+// is available.
+func allocatedSize(fi os.FileInfo) (int64, bool) {
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+
+	return int64(stat.Blocks) * statBlockSize, true //nolint:unconvert
+}
+
+// linkCount returns the number of hard links to the given os.FileInfo value.
+// The returned bool indicates whether the link count is available.
+func linkCount(fi os.FileInfo) (uint64, bool) {
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+
+	return uint64(stat.Nlink), true //nolint:unconvert
+}
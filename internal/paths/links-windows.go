@@ -0,0 +1,30 @@
+//go:build windows
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import "os"
+
+// fileIdentity is a placeholder for Windows where the device and inode
+// number are not exposed by os.FileInfo values.
+func fileIdentity(_ os.FileInfo) (fileID, bool) {
+	return fileID{}, false
+}
+
+// allocatedSize is a placeholder for Windows where the allocated size is not
+// exposed by os.FileInfo values.
+func allocatedSize(_ os.FileInfo) (int64, bool) {
+	return 0, false
+}
+
+// linkCount is a placeholder for Windows where the hard link count is not
+// exposed by os.FileInfo values.
+func linkCount(_ os.FileInfo) (uint64, bool) {
+	return 0, false
+}
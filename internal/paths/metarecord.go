@@ -17,14 +17,24 @@ import (
 )
 
 // ID is a collection of username and group name values, associated with a
-// specific os.FileInfo value.
+// specific os.FileInfo value. UnknownUser and UnknownGroup indicate that no
+// user or group entry was found for the uid or gid value; the associated
+// name value is left empty.
 type ID struct {
-	Username  string
-	UID       int
-	UIDStr    string
-	GroupName string
-	GID       int
-	GIDStr    string
+	Username     string
+	UID          int
+	UIDStr       string
+	UnknownUser  bool
+	GroupName    string
+	GID          int
+	GIDStr       string
+	UnknownGroup bool
+}
+
+// Orphaned indicates whether the uid or gid value does not have a matching
+// user or group entry.
+func (id ID) Orphaned() bool {
+	return id.UnknownUser || id.UnknownGroup
 }
 
 // MetaRecord represents a superset of statistics for a file. This includes
@@ -46,17 +56,47 @@ type MetaRecord struct {
 
 	// ParentDir is the parent directory for the path.
 	ParentDir string
+
+	// Depth is the number of directory levels between the path and the
+	// specified path being evaluated. The specified path is at depth 0.
+	Depth int
+
+	// Symlink indicates whether the path is a symlink. If symlinks are
+	// followed, the os.FileInfo details are for the symlink target.
+	Symlink bool
+
+	// LinkTarget is the target of the symlink as recorded in the link
+	// itself (i.e., not resolved).
+	LinkTarget string
+
+	// Dangling indicates whether the path is a symlink whose target does
+	// not exist.
+	Dangling bool
 }
 
 // MetaRecords is a slice of MetaRecord objects intended for bulk processing.
 type MetaRecords []MetaRecord
 
-// TotalFileSize returns the cumulative size of all MetaRecord objects in the
-// slice in bytes. Since this would also apply to directories, those objects
-// are filtered out in an effort to provide a more accurate value.
+// TotalFileSize returns the cumulative apparent size of all MetaRecord
+// objects in the slice in bytes. Since this would also apply to directories,
+// those objects are filtered out in an effort to provide a more accurate
+// value. Files reachable by more than one name (e.g., hard links) are only
+// counted once.
 func (mr MetaRecords) TotalFileSize() int64 {
+	return mr.TotalFileSizeOf(SizeApparent)
+}
+
+// TotalFileSizeHR returns a human-readable string of the cumulative size of
+// all files in the slice of bytes.
+func (mr MetaRecords) TotalFileSizeHR() string {
+	return units.ByteCountIEC(mr.TotalFileSize())
+}
+
+// FileCount returns the number of MetaRecord objects in the slice which are
+// not directories.
+func (mr MetaRecords) FileCount() int {
 
-	var totalSize int64
+	var count int
 
 	for _, file := range mr {
 
@@ -65,17 +105,29 @@ func (mr MetaRecords) TotalFileSize() int64 {
 			continue
 		}
 
-		totalSize += file.Size()
+		count++
 	}
 
-	return totalSize
+	return count
 
 }
 
-// TotalFileSizeHR returns a human-readable string of the cumulative size of
-// all files in the slice of bytes.
-func (mr MetaRecords) TotalFileSizeHR() string {
-	return units.ByteCountIEC(mr.TotalFileSize())
+// Content returns the MetaRecord objects in the slice which were found within
+// the specified path, excluding the specified path itself.
+func (mr MetaRecords) Content() MetaRecords {
+
+	content := make(MetaRecords, 0, len(mr))
+
+	for _, record := range mr {
+		if record.Depth == 0 {
+			continue
+		}
+
+		content = append(content, record)
+	}
+
+	return content
+
 }
 
 // SizeHR returns a human-readable string of the size of a MetaRecord object.
@@ -84,28 +136,65 @@ func (mr MetaRecord) SizeHR() string {
 	return units.ByteCountIEC(mr.Size())
 }
 
-// AgeExceeded indicates whether a path is older than the specified threshold
-// in days. If the path age is younger or equal to the specified number of
-// days then the threshold is considered uncrossed.
-func AgeExceeded(file os.FileInfo, days int) bool {
+// UnixMode returns the permission bits for a MetaRecord object along with the
+// setuid, setgid and sticky bits in the traditional Unix octal layout (e.g.,
+// 04755). This allows for direct comparison against user-specified octal mode
+// masks.
+func (mr MetaRecord) UnixMode() uint32 {
+	mode := uint32(mr.Mode().Perm())
+
+	if mr.Mode()&os.ModeSetuid != 0 {
+		mode |= 0o4000
+	}
+
+	if mr.Mode()&os.ModeSetgid != 0 {
+		mode |= 0o2000
+	}
+
+	if mr.Mode()&os.ModeSticky != 0 {
+		mode |= 0o1000
+	}
+
+	return mode
+}
+
+// IsSetIDExecutable indicates whether a MetaRecord object is a regular file
+// with an execute bit and either the setuid or setgid bit set.
+func (mr MetaRecord) IsSetIDExecutable() bool {
+	return mr.Mode().IsRegular() &&
+		mr.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 &&
+		mr.Mode().Perm()&0o111 != 0
+}
+
+// IsWorldWritableDirWithoutSticky indicates whether a MetaRecord object is a
+// directory which is writable by all users but is missing the sticky bit
+// which restricts removal of content to the owner.
+func (mr MetaRecord) IsWorldWritableDirWithoutSticky() bool {
+	return mr.IsDir() &&
+		mr.Mode().Perm()&0o002 != 0 &&
+		mr.Mode()&os.ModeSticky == 0
+}
+
+// AgeExceeded indicates whether a path with the given timestamp (e.g., the
+// modification time) is older than the specified threshold age. If the path
+// age is younger or equal to the specified age then the threshold is
+// considered uncrossed.
+func AgeExceeded(fileTime time.Time, age time.Duration) bool {
 
 	var oldFile bool
 
 	now := time.Now()
-	fileModTime := file.ModTime()
 
-	// Flip user specified number of days negative so that we can wind
-	// back that many days from the file modification time. This gives
-	// us our threshold to compare file modification times against.
-	daysBack := -(days)
-	fileAgeThreshold := now.AddDate(0, 0, daysBack)
+	// Wind back the user specified age from the current time. This gives
+	// us our threshold to compare file timestamps against.
+	fileAgeThreshold := now.Add(-age)
 
 	switch {
-	case fileModTime.Before(fileAgeThreshold):
+	case fileTime.Before(fileAgeThreshold):
 		oldFile = true
-	case fileModTime.Equal(fileAgeThreshold):
+	case fileTime.Equal(fileAgeThreshold):
 		oldFile = false
-	case fileModTime.After(fileAgeThreshold):
+	case fileTime.After(fileAgeThreshold):
 		oldFile = false
 	}
 
@@ -113,6 +202,32 @@ func AgeExceeded(file os.FileInfo, days int) bool {
 
 }
 
+// AgeBelow indicates whether a path is younger than the specified threshold
+// age. This is the inverse of AgeExceeded; if the path age is older or equal
+// to the specified age then the threshold is considered uncrossed.
+func AgeBelow(fileTime time.Time, age time.Duration) bool {
+
+	var newFile bool
+
+	now := time.Now()
+
+	// Wind back the user specified age from the current time. This gives
+	// us our threshold to compare file timestamps against.
+	fileAgeThreshold := now.Add(-age)
+
+	switch {
+	case fileTime.After(fileAgeThreshold):
+		newFile = true
+	case fileTime.Equal(fileAgeThreshold):
+		newFile = false
+	case fileTime.Before(fileAgeThreshold):
+		newFile = false
+	}
+
+	return newFile
+
+}
+
 // SortByModTimeAsc sorts slice of MetaRecord objects in ascending order with
 // older values listed first.
 func (mr MetaRecords) SortByModTimeAsc() {
@@ -133,7 +248,7 @@ func (mr MetaRecords) SortByModTimeDesc() {
 // smaller values listed first.
 func (mr MetaRecords) SortBySizeAsc() {
 	sort.Slice(mr, func(i, j int) bool {
-		return mr[i].FileInfo.Size() > mr[j].FileInfo.Size()
+		return mr[i].FileInfo.Size() < mr[j].FileInfo.Size()
 	})
 }
 
@@ -141,6 +256,6 @@ func (mr MetaRecords) SortBySizeAsc() {
 // larger values listed first.
 func (mr MetaRecords) SortBySizeDesc() {
 	sort.Slice(mr, func(i, j int) bool {
-		return mr[i].FileInfo.Size() < mr[j].FileInfo.Size()
+		return mr[i].FileInfo.Size() > mr[j].FileInfo.Size()
 	})
 }
@@ -0,0 +1,180 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+// devFileInfo wraps an os.FileInfo value, reporting the given device ID in
+// place of the device ID of the underlying file.
+type devFileInfo struct {
+	os.FileInfo
+	dev uint64
+}
+
+func (fi devFileInfo) Sys() any {
+	return &syscall.Stat_t{Dev: fi.dev}
+}
+
+func TestSkipFilesystem(t *testing.T) {
+	t.Parallel()
+
+	const rootDev, nfsDev, localDev uint64 = 1, 2, 3
+
+	// base/root is the specified path, base/nfs and base/local are mount
+	// points and base/root/data is a symlink to base/nfs
+	base, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{"root", "nfs", "local"} {
+		if err := os.Mkdir(filepath.Join(base, dir), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(filepath.Join(base, "nfs"), filepath.Join(base, "root", "data")); err != nil {
+		t.Fatal(err)
+	}
+
+	mounts := []MountInfo{
+		{MountID: 1, MountPoint: "/", FSType: "ext4"},
+		{MountID: 2, ParentID: 1, MountPoint: filepath.Join(base, "nfs"), FSType: "nfs4"},
+		{MountID: 3, ParentID: 1, MountPoint: filepath.Join(base, "local"), FSType: "xfs"},
+	}
+
+	tests := []struct {
+		name string
+		opts ProcessOptions
+		path string
+		dev  uint64
+		want bool
+	}{
+		{
+			name: "same filesystem with one-file-system",
+			opts: ProcessOptions{OneFileSystem: true},
+			path: filepath.Join(base, "root"),
+			dev:  rootDev,
+			want: false,
+		},
+		{
+			name: "other filesystem with one-file-system",
+			opts: ProcessOptions{OneFileSystem: true},
+			path: filepath.Join(base, "local"),
+			dev:  localDev,
+			want: true,
+		},
+		{
+			name: "other filesystem without options",
+			path: filepath.Join(base, "nfs"),
+			dev:  nfsDev,
+			want: false,
+		},
+		{
+			name: "matching filesystem type",
+			opts: ProcessOptions{SkipFSTypes: []string{"proc", "nfs4"}, Mounts: mounts},
+			path: filepath.Join(base, "nfs"),
+			dev:  nfsDev,
+			want: true,
+		},
+		{
+			name: "other filesystem type",
+			opts: ProcessOptions{SkipFSTypes: []string{"nfs4"}, Mounts: mounts},
+			path: filepath.Join(base, "local"),
+			dev:  localDev,
+			want: false,
+		},
+		{
+			name: "matching filesystem type on specified path filesystem",
+			opts: ProcessOptions{SkipFSTypes: []string{"ext4"}, Mounts: mounts},
+			path: filepath.Join(base, "root"),
+			dev:  rootDev,
+			want: false,
+		},
+		{
+			name: "path resolving to other mount",
+			opts: ProcessOptions{SkipFSTypes: []string{"nfs4"}, Mounts: mounts},
+			path: filepath.Join(base, "root", "data"),
+			dev:  nfsDev,
+			want: true,
+		},
+		{
+			name: "undetermined filesystem type",
+			opts: ProcessOptions{SkipFSTypes: []string{"nfs4"}, Mounts: mounts},
+			path: filepath.Join(base, "missing"),
+			dev:  nfsDev,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := os.Stat(filepath.Join(base, "root"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fsTypes := make(map[uint64]string)
+			got := tt.opts.skipFilesystem(tt.path, devFileInfo{info, tt.dev}, rootDev, fsTypes)
+			if got != tt.want {
+				t.Errorf("skipFilesystem(%q) = %v; want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkipFilesystemCache(t *testing.T) {
+	t.Parallel()
+
+	base, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := ProcessOptions{
+		SkipFSTypes: []string{"nfs4"},
+		Mounts:      []MountInfo{{MountPoint: base, FSType: "nfs4"}},
+	}
+	fsTypes := make(map[uint64]string)
+
+	if !opts.skipFilesystem(base, devFileInfo{info, 2}, 1, fsTypes) {
+		t.Fatal("expected entry on nfs4 filesystem to be skipped")
+	}
+
+	if fsTypes[2] != "nfs4" {
+		t.Fatalf("cached filesystem type = %q; want %q", fsTypes[2], "nfs4")
+	}
+
+	// cached results are used for later entries with the same device ID
+	// without consulting the mount entries
+	opts.Mounts = nil
+	if !opts.skipFilesystem(filepath.Join(base, "missing"), devFileInfo{info, 2}, 1, fsTypes) {
+		t.Error("expected cached filesystem type to be used")
+	}
+
+	// entries whose filesystem type cannot be determined are cached as such
+	if opts.skipFilesystem(base, devFileInfo{info, 3}, 1, fsTypes) {
+		t.Error("expected entry on undetermined filesystem to be evaluated")
+	}
+	if fsType, cached := fsTypes[3]; !cached || fsType != "" {
+		t.Errorf("cached filesystem type = %q (cached: %v); want empty", fsType, cached)
+	}
+}
@@ -16,21 +16,49 @@ import (
 	"strings"
 
 	"github.com/atc0005/check-path/internal/textutils"
+	"github.com/phayes/permbits"
 )
 
 // Application-specific errors for common path checks.
 var (
-	ErrPathExists           = errors.New("path exists")
-	ErrPathDoesNotExist     = errors.New("path does not exist")
-	ErrPathEmptyString      = errors.New("specified path is empty string")
-	ErrPathCheckFailed      = errors.New("failed to check path")
-	ErrPathCheckCanceled    = errors.New("path check canceled")
-	ErrPathOldFilesFound    = errors.New("old files found in path")
-	ErrPathIgnored          = errors.New("path ignored per request")
-	ErrSizeOfFilesTooLarge  = errors.New("evaluated files in specified path too large")
-	ErrSizeOfFilesTooSmall  = errors.New("evaluated files in specified path too small")
-	ErrPathMissingUsername  = errors.New("requested username not set on file/directory")
-	ErrPathMissingGroupName = errors.New("requested group name not set on file/directory")
+	ErrPathExists              = errors.New("path exists")
+	ErrPathDoesNotExist        = errors.New("path does not exist")
+	ErrPathEmptyString         = errors.New("specified path is empty string")
+	ErrPathCheckFailed         = errors.New("failed to check path")
+	ErrPathCheckCanceled       = errors.New("path check canceled")
+	ErrPathOldFilesFound       = errors.New("old files found in path")
+	ErrPathNewFilesFound       = errors.New("new files found in path")
+	ErrPathNoRecentFiles       = errors.New("no recently modified files found in path")
+	ErrPathIgnored             = errors.New("path ignored per request")
+	ErrSizeOfFilesTooLarge     = errors.New("evaluated files in specified path too large")
+	ErrSizeOfFilesTooSmall     = errors.New("evaluated files in specified path too small")
+	ErrFileTooLarge            = errors.New("file in specified path too large")
+	ErrFileTooManyLinks        = errors.New("file in specified path has too many hard links")
+	ErrFileCountTooLarge       = errors.New("number of files in specified path too large")
+	ErrFileCountTooSmall       = errors.New("number of files in specified path too small")
+	ErrPathMissingUsername     = errors.New("requested username not set on file/directory")
+	ErrPathMissingGroupName    = errors.New("requested group name not set on file/directory")
+	ErrPathOrphanedOwner       = errors.New("file/directory owned by unknown user or group")
+	ErrPathOwnerNotGroupMember = errors.New("owner of file/directory not a member of requested group")
+	ErrPathPermissions         = errors.New("unexpected permissions set on file/directory")
+	ErrPathModeTooPermissive   = errors.New("mode for file/directory permits more than allowed")
+	ErrPathSetIDExecutable     = errors.New("unexpected setuid/setgid executable found")
+	ErrPathWorldWritableDir    = errors.New("world-writable directory without sticky bit found")
+	ErrFilesystemLowSpace      = errors.New("free space on filesystem hosting specified path below threshold")
+	ErrFilesystemLowInodes     = errors.New("free inodes on filesystem hosting specified path below threshold")
+	ErrPathNotMountPoint       = errors.New("specified path is not a mount point")
+	ErrFilesystemType          = errors.New("unexpected filesystem type hosting specified path")
+	ErrMountOptionMissing      = errors.New("required mount option not set for filesystem hosting specified path")
+	ErrPathNotDirectory        = errors.New("specified path is not a directory")
+	ErrDirectoryNotEmpty       = errors.New("specified directory is not empty")
+	ErrDirectoryEmpty          = errors.New("specified directory is empty")
+	ErrPathUnexpectedType      = errors.New("specified path is not of the expected type")
+	ErrFileTypeUnexpected      = errors.New("unexpected file type found in path")
+	ErrDanglingSymlink         = errors.New("symlink with missing target found in path")
+	ErrPathNotSymlink          = errors.New("specified path is not a symlink")
+	ErrSymlinkTarget           = errors.New("symlink target does not match expected target")
+	ErrSymlinkTargetNotNewest  = errors.New("symlink target is not the newest entry in its parent directory")
+	ErrPathXattr               = errors.New("unexpected extended attributes on file/directory")
 )
 
 // ProcessResult is a superset of a MetaRecord and any associated error
@@ -111,9 +139,10 @@ func Info(path string) (MetaRecord, error) {
 
 	// path found
 	return MetaRecord{
-		FileInfo:  pathInfo,
-		FQPath:    path,
-		ParentDir: filepath.Dir(path),
+		FileInfo:    pathInfo,
+		Permissions: permbits.FileMode(pathInfo.Mode()),
+		FQPath:      path,
+		ParentDir:   filepath.Dir(path),
 	}, nil
 }
 
@@ -144,10 +173,89 @@ func Exists(path string) (bool, error) {
 	return true, nil
 }
 
+// ProcessOptions controls how Process walks a specified path.
+type ProcessOptions struct {
+
+	// IgnoreList is the list of fully-qualified paths to ignore. Ignored
+	// paths are reported using the ErrPathIgnored error.
+	IgnoreList []string
+
+	// Recurse indicates whether subdirectories are evaluated.
+	Recurse bool
+
+	// MaxDepth is the maximum depth (relative to the specified path at depth
+	// 0) of evaluated content. A value of 0 indicates no limit.
+	MaxDepth int
+
+	// MinDepth is the minimum depth (relative to the specified path at depth
+	// 0) of evaluated content. Content above this depth is searched, but not
+	// reported.
+	MinDepth int
+
+	// Filter is applied to entries below the specified path. Entries which
+	// are not included or which are excluded are skipped without being
+	// reported. Excluded directories are not descended into.
+	Filter PathFilter
+
+	// FollowSymlinks indicates whether symlinks (including the specified
+	// path) are followed. Directories reached by way of a symlink loop are
+	// reported, but not descended into.
+	FollowSymlinks bool
+
+	// OneFileSystem indicates whether entries hosted by a filesystem other
+	// than the one hosting the specified path (e.g., bind mounts or network
+	// filesystem submounts) are skipped without being reported. Directories
+	// on other filesystems are not descended into.
+	OneFileSystem bool
+
+	// SkipFSTypes is the list of filesystem types (e.g., proc, nfs4) whose
+	// entries are skipped in the same manner as for OneFileSystem. Entries
+	// hosted by the filesystem of the specified path are always evaluated.
+	SkipFSTypes []string
+
+	// Mounts are the mount entries used to determine the type of each
+	// filesystem encountered below the specified path. Required if
+	// SkipFSTypes is specified.
+	Mounts []MountInfo
+}
+
+// skipFilesystem indicates whether an entry is hosted by a filesystem which
+// is skipped per the OneFileSystem and SkipFSTypes options. The device ID of
+// the specified path is given by rootDev. Filesystem types are cached by
+// device ID in fsTypes.
+func (opts ProcessOptions) skipFilesystem(path string, info os.FileInfo, rootDev uint64, fsTypes map[uint64]string) bool {
+
+	id, ok := fileIdentity(info)
+	if !ok || id.dev == rootDev {
+		return false
+	}
+
+	if opts.OneFileSystem {
+		return true
+	}
+
+	if len(opts.SkipFSTypes) == 0 {
+		return false
+	}
+
+	fsType, cached := fsTypes[id.dev]
+	if !cached {
+		// an entry whose filesystem cannot be determined is evaluated
+		if resolved, err := resolvePath(path); err == nil {
+			if mount, found := FindMount(opts.Mounts, resolved); found {
+				fsType = mount.FSType
+			}
+		}
+		fsTypes[id.dev] = fsType
+	}
+
+	return textutils.InList(fsType, opts.SkipFSTypes)
+}
+
 // Process evalutes the specified path, either at a flat level or if
 // specified, recursively. ProcessResult values are sent back by way of a
 // results channel.
-func Process(ctx context.Context, path string, ignoreList []string, recurse bool, results chan<- ProcessResult) {
+func Process(ctx context.Context, path string, opts ProcessOptions, results chan<- ProcessResult) {
 
 	// NOTE: This is safe to close *ONLY* because we recreate the channel on
 	// each iteration of the specified paths (e.g., one path at a time) before
@@ -163,7 +271,12 @@ func Process(ctx context.Context, path string, ignoreList []string, recurse bool
 		return
 	}
 
-	walkErr := filepath.Walk(fqPath, func(path string, info os.FileInfo, err error) error {
+	// device ID of the specified path and filesystem types of other devices
+	// found within it; used to skip entries on other filesystems
+	var rootDev uint64
+	fsTypes := make(map[uint64]string)
+
+	walkErr := walk(fqPath, opts.FollowSymlinks, func(path string, info os.FileInfo, err error) error {
 
 		// If we return a non-nil error, this will stop the filepath.Walk()
 		// function from continuing to walk the path.
@@ -185,7 +298,7 @@ func Process(ctx context.Context, path string, ignoreList []string, recurse bool
 			return err
 
 		// OK: we're excluding this path from further checks
-		case textutils.InList(path, ignoreList):
+		case textutils.InList(path, opts.IgnoreList):
 
 			// report this as an error result, but of a type that the channel
 			// consumer will recognize as a special case
@@ -207,19 +320,66 @@ func Process(ctx context.Context, path string, ignoreList []string, recurse bool
 		// is a directory & not fully-qualified, specified path; skip if
 		// recurse is not enabled
 		case info.IsDir() && path != fqPath:
-			if !recurse {
+			if !opts.Recurse {
 				return filepath.SkipDir
 			}
 		}
 
-		mr := MetaRecord{
-			FileInfo:  info,
-			FQPath:    path,
-			ParentDir: filepath.Dir(path),
+		// The specified path itself is at depth 0; content directly within
+		// it is at depth 1 and so on.
+		var depth int
+
+		if path == fqPath {
+			if id, ok := fileIdentity(info); ok {
+				rootDev = id.dev
+			}
+		}
+
+		// The specified path itself is always evaluated (unless a minimum
+		// depth is specified); include and exclude patterns only apply to
+		// content found within it.
+		if path != fqPath {
+			relPath, relErr := filepath.Rel(fqPath, path)
+			if relErr != nil {
+				return relErr
+			}
+			relPath = filepath.ToSlash(relPath)
+			depth = strings.Count(relPath, "/") + 1
+
+			switch {
+			case opts.skipFilesystem(path, info, rootDev, fsTypes):
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+
+			case opts.MaxDepth > 0 && depth > opts.MaxDepth:
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+
+			case opts.Filter.Excluded(relPath):
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+
+			// directories which are not included are still descended into
+			// so that included content within them is evaluated
+			case !opts.Filter.Included(relPath):
+				return nil
+			}
+		}
+
+		// content above the minimum depth is still descended into, but is
+		// not evaluated
+		if depth < opts.MinDepth {
+			return nil
 		}
 
 		results <- ProcessResult{
-			MetaRecord: mr,
+			MetaRecord: newMetaRecord(path, info, depth),
 		}
 
 		// indicate no error to filepath.Walk() so that it will continue to
@@ -235,3 +395,63 @@ func Process(ctx context.Context, path string, ignoreList []string, recurse bool
 	}
 
 }
+
+// newMetaRecord returns a MetaRecord for a walked entry at the given depth.
+func newMetaRecord(path string, info os.FileInfo, depth int) MetaRecord {
+
+	symlink, linkTarget, dangling := symlinkDetails(path, info)
+
+	return MetaRecord{
+		FileInfo:    info,
+		Permissions: permbits.FileMode(info.Mode()),
+		FQPath:      path,
+		ParentDir:   filepath.Dir(path),
+		Depth:       depth,
+		Symlink:     symlink,
+		LinkTarget:  linkTarget,
+		Dangling:    dangling,
+	}
+}
+
+// DirectContent returns MetaRecord values for the entries directly within
+// the specified directory, including subdirectories, regardless of whether
+// recursion is enabled. Entries are skipped per the ignore list and include
+// and exclude patterns in the same manner as Process. This is used to
+// evaluate the content of a directory when Process does not report
+// subdirectories (e.g., when recursion is disabled).
+func DirectContent(path string, opts ProcessOptions) (MetaRecords, error) {
+
+	fqPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := readDirNames(fqPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", fqPath, err)
+	}
+
+	records := make(MetaRecords, 0, len(names))
+	for _, name := range names {
+		filename := filepath.Join(fqPath, name)
+
+		if textutils.InList(filename, opts.IgnoreList) ||
+			opts.Filter.Excluded(name) ||
+			!opts.Filter.Included(name) {
+			continue
+		}
+
+		info, err := statEntry(filename, opts.FollowSymlinks)
+		if err != nil {
+			// entry removed since the directory was read
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		records = append(records, newMetaRecord(filename, info, 1))
+	}
+
+	return records, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirectContent(t *testing.T) {
+	t.Parallel()
+
+	// directory containing only a subdirectory
+	onlySubdir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(onlySubdir, "sub"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(onlySubdir, "sub", "nested"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// directory containing only ignored and excluded content
+	filtered := t.TempDir()
+	for _, name := range []string{".keep", "ignored"} {
+		if err := os.WriteFile(filepath.Join(filtered, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	excludeFilter, err := NewPathFilter(nil, []string{".keep"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		opts ProcessOptions
+		want []string
+	}{
+		{
+			name: "only subdirectory",
+			path: onlySubdir,
+			want: []string{filepath.Join(onlySubdir, "sub")},
+		},
+		{
+			name: "ignored and excluded content",
+			path: filtered,
+			opts: ProcessOptions{
+				IgnoreList: []string{filepath.Join(filtered, "ignored")},
+				Filter:     excludeFilter,
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			records, err := DirectContent(tt.path, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(records) != len(tt.want) {
+				t.Fatalf("got %d records; want %d", len(records), len(tt.want))
+			}
+
+			for i, record := range records {
+				if record.FQPath != tt.want[i] {
+					t.Errorf("record %d = %q; want %q", i, record.FQPath, tt.want[i])
+				}
+				if record.Depth != 1 {
+					t.Errorf("record %d depth = %d; want 1", i, record.Depth)
+				}
+			}
+		})
+	}
+}
+
+func TestDirectContentNotDirectory(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := DirectContent(file, ProcessOptions{}); err == nil {
+		t.Error("expected error for path which is not a directory")
+	}
+}
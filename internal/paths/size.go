@@ -0,0 +1,107 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+// Supported size sources for size evaluation.
+const (
+	SizeApparent  = "apparent"
+	SizeAllocated = "allocated"
+)
+
+// fileID uniquely identifies a file by the device it resides on and its
+// inode number. Hard links to the same file share the same fileID value.
+type fileID struct {
+	dev uint64
+	ino uint64
+}
+
+// SizeOf returns the size in bytes of the MetaRecord using the specified
+// size source. The apparent size is the length of the file while the
+// allocated size is the disk space allocated to the file; the allocated size
+// is smaller than the apparent size for sparse files and larger for small
+// files. If the allocated size is not available, the apparent size is
+// returned.
+func (mr MetaRecord) SizeOf(source string) int64 {
+	if source == SizeAllocated {
+		if size, ok := allocatedSize(mr.FileInfo); ok {
+			return size
+		}
+	}
+
+	return mr.Size()
+}
+
+// Links returns the number of hard links to the MetaRecord. The returned
+// bool indicates whether the link count is available.
+func (mr MetaRecord) Links() (uint64, bool) {
+	return linkCount(mr.FileInfo)
+}
+
+// TotalFileSizeOf returns the cumulative size in bytes of all MetaRecord
+// objects in the slice using the specified size source. Directories are
+// skipped and files reachable by more than one name (e.g., hard links or
+// followed symlinks) are only counted once.
+func (mr MetaRecords) TotalFileSizeOf(source string) int64 {
+	tally := NewSizeTally(source)
+	tally.Add(mr...)
+
+	return tally.Total()
+}
+
+// SizeTally accumulates the cumulative size in bytes of MetaRecord objects as
+// they are added using the specified size source. Directories are skipped
+// and files reachable by more than one name (e.g., hard links or followed
+// symlinks) are only counted once across all added MetaRecord objects.
+type SizeTally struct {
+	source  string
+	seen    map[fileID]struct{}
+	total   int64
+	records int
+}
+
+// NewSizeTally returns an empty SizeTally using the specified size source.
+func NewSizeTally(source string) *SizeTally {
+	return &SizeTally{
+		source: source,
+		seen:   make(map[fileID]struct{}),
+	}
+}
+
+// Add accumulates the size of the given MetaRecord objects.
+func (st *SizeTally) Add(mrs ...MetaRecord) {
+	for _, file := range mrs {
+
+		st.records++
+
+		// Skip any directory (MetaRecord) entries that may have been added.
+		if file.IsDir() {
+			continue
+		}
+
+		if id, ok := fileIdentity(file.FileInfo); ok {
+			if _, dup := st.seen[id]; dup {
+				continue
+			}
+			st.seen[id] = struct{}{}
+		}
+
+		st.total += file.SizeOf(st.source)
+	}
+}
+
+// Total returns the cumulative size in bytes of all added MetaRecord
+// objects.
+func (st *SizeTally) Total() int64 {
+	return st.total
+}
+
+// Len returns the number of MetaRecord objects added, including directories
+// and files counted only once.
+func (st *SizeTally) Len() int {
+	return st.records
+}
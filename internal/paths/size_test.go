@@ -0,0 +1,95 @@
+//go:build !windows
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTotalFileSizeHardLinks(t *testing.T) {
+	t.Parallel()
+
+	base := t.TempDir()
+
+	original := filepath.Join(base, "original")
+	if err := os.WriteFile(original, make([]byte, 100), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	other := filepath.Join(base, "other")
+	if err := os.WriteFile(other, make([]byte, 10), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"link1", "link2"} {
+		if err := os.Link(original, filepath.Join(base, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var records MetaRecords
+	for _, name := range []string{"", "link1", "link2", "original", "other"} {
+		path := filepath.Join(base, name)
+		info, err := os.Lstat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, MetaRecord{FileInfo: info, FQPath: path})
+	}
+
+	if got, want := records.TotalFileSize(), int64(110); got != want {
+		t.Errorf("TotalFileSize() = %d; want %d", got, want)
+	}
+
+	links, ok := records[1].Links()
+	if !ok {
+		t.Fatal("link count not available")
+	}
+	if links != 3 {
+		t.Errorf("Links() = %d; want 3", links)
+	}
+}
+
+func TestSizeTallyHardLinks(t *testing.T) {
+	t.Parallel()
+
+	base := t.TempDir()
+
+	original := filepath.Join(base, "original")
+	if err := os.WriteFile(original, make([]byte, 100), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Link(original, filepath.Join(base, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	tally := NewSizeTally(SizeApparent)
+
+	// hard links added separately are still only counted once
+	for _, name := range []string{"", "original", "link"} {
+		path := filepath.Join(base, name)
+		info, err := os.Lstat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tally.Add(MetaRecord{FileInfo: info, FQPath: path})
+	}
+
+	if got, want := tally.Total(), int64(100); got != want {
+		t.Errorf("Total() = %d; want %d", got, want)
+	}
+
+	if got, want := tally.Len(), 3; got != want {
+		t.Errorf("Len() = %d; want %d", got, want)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SymlinkTargets represents the target of a symlink, both as recorded in the
+// link itself and with all symlinks resolved.
+type SymlinkTargets struct {
+
+	// Direct is the absolute form of the target recorded in the symlink. A
+	// relative target is interpreted relative to the directory containing
+	// the symlink.
+	Direct string
+
+	// Resolved is the absolute form of the target with all symlinks
+	// resolved.
+	Resolved string
+}
+
+// SymlinkTarget returns the targets of the symlink at the specified path. An
+// error is returned if the path is not a symlink or if the target does not
+// exist.
+func SymlinkTarget(path string) (SymlinkTargets, error) {
+
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return SymlinkTargets{}, fmt.Errorf("%w: %s", ErrPathDoesNotExist, path)
+		}
+		return SymlinkTargets{}, fmt.Errorf("error checking path %s: %w", path, err)
+	}
+
+	if info.Mode()&os.ModeSymlink == 0 {
+		return SymlinkTargets{}, fmt.Errorf("%w: %s", ErrPathNotSymlink, path)
+	}
+
+	direct, err := os.Readlink(path)
+	if err != nil {
+		return SymlinkTargets{}, fmt.Errorf("failed to read symlink %s: %w", path, err)
+	}
+
+	if !filepath.IsAbs(direct) {
+		direct = filepath.Join(filepath.Dir(path), direct)
+	}
+
+	direct, err = filepath.Abs(direct)
+	if err != nil {
+		return SymlinkTargets{}, fmt.Errorf("failed to resolve symlink %s: %w", path, err)
+	}
+
+	resolved, err := resolvePath(path)
+	switch {
+	case errors.Is(err, ErrPathDoesNotExist):
+		return SymlinkTargets{Direct: direct}, fmt.Errorf(
+			"%w: %s -> %s",
+			ErrDanglingSymlink,
+			path,
+			direct,
+		)
+	case err != nil:
+		return SymlinkTargets{Direct: direct}, err
+	}
+
+	return SymlinkTargets{
+		Direct:   direct,
+		Resolved: resolved,
+	}, nil
+}
+
+// Supported orderings used to determine the newest entry within a
+// directory.
+const (
+
+	// SiblingOrderVersion orders entries by name, comparing runs of digits
+	// numerically (e.g., release-9 before release-10).
+	SiblingOrderVersion = "version"
+
+	// SiblingOrderName orders entries lexically by name.
+	SiblingOrderName = "name"
+
+	// SiblingOrderModified orders entries by modification time. The
+	// modification time of a directory changes whenever an entry is created
+	// or removed within it, so this ordering is best suited to files.
+	SiblingOrderModified = "mtime"
+)
+
+// NewestSibling returns the newest entry within the parent directory of the
+// specified path using the specified ordering. Only entries of the same kind
+// (directory or not) as the specified path are considered; symlinks within
+// the parent directory are not followed. Entries which are equal per the
+// specified ordering are ordered by version and then by name so that the
+// result does not depend on the specified path.
+func NewestSibling(path string, order string) (string, error) {
+
+	switch order {
+	case SiblingOrderVersion, SiblingOrderName, SiblingOrderModified:
+	default:
+		return "", fmt.Errorf("unsupported sibling order %q", order)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("error checking path %s: %w", path, err)
+	}
+
+	parent := filepath.Dir(path)
+
+	entries, err := os.ReadDir(parent)
+	if err != nil {
+		return "", fmt.Errorf("failed to read directory %s: %w", parent, err)
+	}
+
+	var newest os.FileInfo
+
+	for _, entry := range entries {
+		entryInfo, err := entry.Info()
+		if err != nil {
+			// entry removed since the directory was read
+			continue
+		}
+
+		if entryInfo.IsDir() != info.IsDir() || entryInfo.Mode()&os.ModeSymlink != 0 {
+			continue
+		}
+
+		if newest == nil || compareSiblings(entryInfo, newest, order) > 0 {
+			newest = entryInfo
+		}
+	}
+
+	// the specified path was removed since it was examined
+	if newest == nil {
+		return path, nil
+	}
+
+	return filepath.Join(parent, newest.Name()), nil
+}
+
+// compareSiblings compares two directory entries using the specified
+// ordering, returning a positive value if a is newer than b, a negative
+// value if a is older than b and zero if they are the same entry.
+func compareSiblings(a os.FileInfo, b os.FileInfo, order string) int {
+
+	switch order {
+	case SiblingOrderModified:
+		if cmp := a.ModTime().Compare(b.ModTime()); cmp != 0 {
+			return cmp
+		}
+
+	case SiblingOrderName:
+		return strings.Compare(a.Name(), b.Name())
+	}
+
+	if cmp := compareVersions(a.Name(), b.Name()); cmp != 0 {
+		return cmp
+	}
+
+	return strings.Compare(a.Name(), b.Name())
+}
+
+// compareVersions compares two names, treating runs of digits as numbers
+// and all other characters lexically. Leading zeros are ignored, so v01 and
+// v1 compare as equal.
+func compareVersions(a string, b string) int {
+
+	for a != "" && b != "" {
+		aChunk, aDigits := nextVersionChunk(a)
+		bChunk, bDigits := nextVersionChunk(b)
+		a, b = a[len(aChunk):], b[len(bChunk):]
+
+		switch {
+		case aDigits && bDigits:
+			aNum := strings.TrimLeft(aChunk, "0")
+			bNum := strings.TrimLeft(bChunk, "0")
+			if len(aNum) != len(bNum) {
+				return cmpInt(len(aNum), len(bNum))
+			}
+			if cmp := strings.Compare(aNum, bNum); cmp != 0 {
+				return cmp
+			}
+
+		default:
+			if cmp := strings.Compare(aChunk, bChunk); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+
+	return cmpInt(len(a), len(b))
+}
+
+// nextVersionChunk returns the leading run of either digits or non-digits
+// from the given string and whether the run consists of digits.
+func nextVersionChunk(s string) (string, bool) {
+	digits := isDigit(s[0])
+
+	end := 1
+	for end < len(s) && isDigit(s[end]) == digits {
+		end++
+	}
+
+	return s[:end], digits
+}
+
+// isDigit indicates whether the given byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// cmpInt compares two int values in the same manner as strings.Compare.
+func cmpInt(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// resolvePath returns the absolute form of the specified path with all
+// symlinks resolved.
+func resolvePath(path string) (string, error) {
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: %s", ErrPathDoesNotExist, path)
+		}
+		return "", fmt.Errorf("failed to resolve path %s: %w", path, err)
+	}
+
+	resolved, err = filepath.Abs(resolved)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path %s: %w", path, err)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "release-10", b: "release-9", want: 1},
+		{a: "release-9", b: "release-10", want: -1},
+		{a: "1.2.10", b: "1.2.9", want: 1},
+		{a: "1.10.0", b: "1.9.99", want: 1},
+		{a: "v01", b: "v1", want: 0},
+		{a: "v1", b: "v1a", want: -1},
+		{a: "v1.0", b: "v1", want: 1},
+		{a: "b", b: "a10", want: 1},
+		{a: "20260101", b: "20251231", want: 1},
+		{a: "same", b: "same", want: 0},
+		{a: "", b: "a", want: -1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			if got := compareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareVersions(%q, %q) = %d; want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewestSibling(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// modification times are the reverse of the version order so that each
+	// ordering selects a different entry
+	base := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	releases := []struct {
+		name  string
+		mtime time.Time
+	}{
+		{name: "release-10", mtime: base},
+		{name: "release-9", mtime: base.Add(time.Hour)},
+		{name: "release-8", mtime: base.Add(2 * time.Hour)},
+	}
+	for _, r := range releases {
+		path := filepath.Join(dir, r.name)
+		if err := os.Mkdir(path, 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, r.mtime, r.mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// files are not considered when the path is a directory
+	newerFile := filepath.Join(dir, "release-99.tar")
+	if err := os.WriteFile(newerFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// entries which share a modification time are ordered by version
+	tied := t.TempDir()
+	for _, name := range []string{"v2", "v10", "v1"} {
+		path := filepath.Join(tied, name)
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, base, base); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		order string
+		want  string
+	}{
+		{
+			name:  "version",
+			path:  filepath.Join(dir, "release-8"),
+			order: SiblingOrderVersion,
+			want:  filepath.Join(dir, "release-10"),
+		},
+		{
+			name:  "name",
+			path:  filepath.Join(dir, "release-10"),
+			order: SiblingOrderName,
+			want:  filepath.Join(dir, "release-9"),
+		},
+		{
+			name:  "mtime",
+			path:  filepath.Join(dir, "release-10"),
+			order: SiblingOrderModified,
+			want:  filepath.Join(dir, "release-8"),
+		},
+		{
+			name:  "file considers only files",
+			path:  newerFile,
+			order: SiblingOrderVersion,
+			want:  newerFile,
+		},
+		{
+			name:  "mtime tie from oldest version",
+			path:  filepath.Join(tied, "v1"),
+			order: SiblingOrderModified,
+			want:  filepath.Join(tied, "v10"),
+		},
+		{
+			name:  "mtime tie from newest version",
+			path:  filepath.Join(tied, "v10"),
+			order: SiblingOrderModified,
+			want:  filepath.Join(tied, "v10"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewestSibling(tt.path, tt.order)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("NewestSibling(%q, %q) = %q; want %q", tt.path, tt.order, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewestSiblingUnsupportedOrder(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewestSibling(t.TempDir(), "size"); err == nil {
+		t.Error("expected error for unsupported order")
+	}
+}
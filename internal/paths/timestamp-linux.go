@@ -0,0 +1,76 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// platformTimestamp retrieves the status change, access or birth time for
+// the given MetaRecord. The status change and access times are taken from
+// the underlying syscall.Stat_t value. Birth time is retrieved using statx
+// and is only available if the filesystem records it. As with the other
+// timestamps, the birth time of a followed symlink is that of its target.
+func platformTimestamp(mr MetaRecord, source string) (time.Time, error) {
+
+	if source == TimestampBirth {
+		var stx unix.Statx_t
+
+		// a symlink whose details are not those of a symlink was followed
+		flags := unix.AT_SYMLINK_NOFOLLOW
+		if mr.Symlink && mr.Mode()&os.ModeSymlink == 0 {
+			flags = 0
+		}
+
+		err := unix.Statx(
+			unix.AT_FDCWD,
+			mr.FQPath,
+			flags,
+			unix.STATX_BTIME,
+			&stx,
+		)
+		if err != nil {
+			return time.Time{}, fmt.Errorf(
+				"%w: statx call failed: %v",
+				ErrTimestampUnsupported,
+				err,
+			)
+		}
+
+		if stx.Mask&unix.STATX_BTIME == 0 {
+			return time.Time{}, fmt.Errorf(
+				"%w: filesystem does not record birth time",
+				ErrTimestampUnsupported,
+			)
+		}
+
+		return time.Unix(stx.Btime.Sec, int64(stx.Btime.Nsec)), nil
+	}
+
+	stat, ok := mr.Sys().(*syscall.Stat_t)
+	if !ok {
+		return time.Time{}, fmt.Errorf(
+			"%w: unable to retrieve file status",
+			ErrTimestampUnsupported,
+		)
+	}
+
+	switch source {
+	case TimestampChanged:
+		return time.Unix(stat.Ctim.Unix()), nil
+	default:
+		return time.Unix(stat.Atim.Unix()), nil
+	}
+}
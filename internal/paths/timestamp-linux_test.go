@@ -0,0 +1,125 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestMetaRecordTimestampLinux(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now().Add(-time.Second)
+	atime := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+	mtime := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	record := timestampTestRecord(t, atime, mtime)
+
+	got, err := record.Timestamp(TimestampAccessed)
+	switch {
+	case err != nil:
+		t.Errorf("unexpected error for %s: %v", TimestampAccessed, err)
+	case !got.Equal(atime):
+		t.Errorf("Timestamp(%q) = %v; want %v", TimestampAccessed, got, atime)
+	}
+
+	// the status change time is updated when the timestamps are set
+	got, err = record.Timestamp(TimestampChanged)
+	switch {
+	case err != nil:
+		t.Errorf("unexpected error for %s: %v", TimestampChanged, err)
+	case got.Before(start):
+		t.Errorf("Timestamp(%q) = %v; want no earlier than %v", TimestampChanged, got, start)
+	}
+
+	// birth time is only available if recorded by the filesystem
+	got, err = record.Timestamp(TimestampBirth)
+	switch {
+	case errors.Is(err, ErrTimestampUnsupported):
+	case err != nil:
+		t.Errorf("unexpected error for %s: %v", TimestampBirth, err)
+	case got.Before(start):
+		t.Errorf("Timestamp(%q) = %v; want no earlier than %v", TimestampBirth, got, start)
+	}
+}
+
+// birthTime returns the birth time of the given path without following
+// symlinks, skipping the test if the filesystem does not record it.
+func birthTime(t *testing.T, path string) time.Time {
+	t.Helper()
+
+	var stx unix.Statx_t
+	err := unix.Statx(unix.AT_FDCWD, path, unix.AT_SYMLINK_NOFOLLOW, unix.STATX_BTIME, &stx)
+	if err != nil || stx.Mask&unix.STATX_BTIME == 0 {
+		t.Skip("filesystem does not record birth time")
+	}
+
+	return time.Unix(stx.Btime.Sec, int64(stx.Btime.Nsec))
+}
+
+func TestMetaRecordBirthTimeSymlink(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	link := filepath.Join(dir, "link")
+
+	if err := os.WriteFile(target, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	targetBirth := birthTime(t, target)
+
+	// ensure the symlink is created after the target
+	time.Sleep(20 * time.Millisecond)
+
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatal(err)
+	}
+	linkBirth := birthTime(t, link)
+
+	if linkBirth.Equal(targetBirth) {
+		t.Skip("filesystem birth time resolution too coarse")
+	}
+
+	tests := []struct {
+		name           string
+		followSymlinks bool
+		want           time.Time
+	}{
+		{name: "followed symlink", followSymlinks: true, want: targetBirth},
+		{name: "symlink not followed", followSymlinks: false, want: linkBirth},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := statEntry(link, tt.followSymlinks)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := newMetaRecord(link, info, 0).Timestamp(TimestampBirth)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("Timestamp(%q) = %v; want %v", TimestampBirth, got, tt.want)
+			}
+		})
+	}
+}
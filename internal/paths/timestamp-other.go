@@ -0,0 +1,27 @@
+//go:build !linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// platformTimestamp is a placeholder for operating systems where only the
+// modification time is currently supported.
+func platformTimestamp(_ MetaRecord, source string) (time.Time, error) {
+	return time.Time{}, fmt.Errorf(
+		"%w: %s not supported on %s",
+		ErrTimestampUnsupported,
+		source,
+		runtime.GOOS,
+	)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Supported timestamp sources for age evaluation.
+const (
+
+	// TimestampModified selects the file modification time
+	TimestampModified string = "mtime"
+
+	// TimestampChanged selects the file status change time
+	TimestampChanged string = "ctime"
+
+	// TimestampAccessed selects the file access time
+	TimestampAccessed string = "atime"
+
+	// TimestampBirth selects the file birth (creation) time
+	TimestampBirth string = "btime"
+)
+
+// ErrTimestampUnsupported indicates that the requested timestamp source is
+// not available for a path, either because the operating system does not
+// expose it or because the filesystem does not record it.
+var ErrTimestampUnsupported = errors.New("timestamp source not supported")
+
+// TimestampedRecord pairs a MetaRecord with the timestamp selected for age
+// evaluation.
+type TimestampedRecord struct {
+	MetaRecord
+
+	// Timestamp is the value of the selected timestamp source for the
+	// associated path.
+	Timestamp time.Time
+}
+
+// TimestampedRecords is a slice of TimestampedRecord objects intended for
+// bulk processing.
+type TimestampedRecords []TimestampedRecord
+
+// Timestamp returns the value of the specified timestamp source for the
+// MetaRecord. The modification time is always available; other sources
+// depend on support from the operating system and filesystem.
+func (mr MetaRecord) Timestamp(source string) (time.Time, error) {
+	switch source {
+	case TimestampModified, "":
+		return mr.ModTime(), nil
+	case TimestampChanged, TimestampAccessed, TimestampBirth:
+		return platformTimestamp(mr, source)
+	default:
+		return time.Time{}, fmt.Errorf(
+			"%w: unknown timestamp source %q",
+			ErrTimestampUnsupported,
+			source,
+		)
+	}
+}
+
+// WithTimestamps returns the MetaRecord values in the slice paired with the
+// specified timestamp source. An error is returned for the first path where
+// the timestamp source is unavailable.
+func (mr MetaRecords) WithTimestamps(source string) (TimestampedRecords, error) {
+	records := make(TimestampedRecords, 0, len(mr))
+
+	for _, record := range mr {
+		ts, err := record.Timestamp(source)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to retrieve %s for %s: %w",
+				source,
+				record.FQPath,
+				err,
+			)
+		}
+
+		records = append(records, TimestampedRecord{
+			MetaRecord: record,
+			Timestamp:  ts,
+		})
+	}
+
+	return records, nil
+}
+
+// SortByTimestampAsc sorts slice of TimestampedRecord objects in ascending
+// order with older values listed first.
+func (tr TimestampedRecords) SortByTimestampAsc() {
+	sort.Slice(tr, func(i, j int) bool {
+		return tr[i].Timestamp.Before(tr[j].Timestamp)
+	})
+}
+
+// SortByTimestampDesc sorts slice of TimestampedRecord objects in descending
+// order with newer values listed first.
+func (tr TimestampedRecords) SortByTimestampDesc() {
+	sort.Slice(tr, func(i, j int) bool {
+		return tr[i].Timestamp.After(tr[j].Timestamp)
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// timestampTestRecord creates a file with the given access and modification
+// times and returns a MetaRecord for it.
+func timestampTestRecord(t *testing.T, atime time.Time, mtime time.Time) MetaRecord {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, atime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Lstat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return newMetaRecord(path, info, 0)
+}
+
+func TestMetaRecordTimestamp(t *testing.T) {
+	t.Parallel()
+
+	atime := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+	mtime := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	record := timestampTestRecord(t, atime, mtime)
+
+	tests := []struct {
+		name    string
+		source  string
+		want    time.Time
+		wantErr error
+	}{
+		{name: "modification time", source: TimestampModified, want: mtime},
+		{name: "default source", source: "", want: mtime},
+		{name: "unknown source", source: "xtime", wantErr: ErrTimestampUnsupported},
+		{name: "source is case sensitive", source: "MTIME", wantErr: ErrTimestampUnsupported},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := record.Timestamp(tt.source)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v; want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("Timestamp(%q) = %v; want %v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTimestampsUnknownSource(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	records := MetaRecords{timestampTestRecord(t, now, now)}
+
+	if _, err := records.WithTimestamps("xtime"); !errors.Is(err, ErrTimestampUnsupported) {
+		t.Errorf("got error %v; want %v", err, ErrTimestampUnsupported)
+	}
+}
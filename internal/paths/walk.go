@@ -0,0 +1,160 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// followedSymlink describes a followed symlink using the details of its
+// target. The type is used to distinguish followed symlinks from other
+// entries.
+type followedSymlink struct {
+	os.FileInfo
+}
+
+// walk walks the file tree rooted at root in lexical order, calling fn for
+// each file or directory in the tree (including root) in the same manner as
+// filepath.Walk. If followSymlinks is true, symlinks (including root) are
+// followed and the details of their targets are provided to fn; symlinks
+// whose targets cannot be resolved are provided as-is. Directories which are
+// already present in the chain of ancestors of a followed symlink are
+// provided to fn, but not descended into in order to avoid symlink loops.
+func walk(root string, followSymlinks bool, fn filepath.WalkFunc) error {
+
+	info, err := statEntry(root, followSymlinks)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		err = walkEntry(root, info, followSymlinks, nil, fn)
+	}
+
+	if errors.Is(err, filepath.SkipDir) {
+		return nil
+	}
+
+	return err
+}
+
+// walkEntry recursively descends path, calling fn for path and each entry
+// within it. The ancestors of path are used to detect symlink loops.
+func walkEntry(path string, info os.FileInfo, followSymlinks bool, ancestors []os.FileInfo, fn filepath.WalkFunc) error {
+
+	if !info.IsDir() {
+		return fn(path, info, nil)
+	}
+
+	dirInfo := info
+	if followed, ok := info.(followedSymlink); ok {
+		dirInfo = followed.FileInfo
+	}
+
+	for _, ancestor := range ancestors {
+		if os.SameFile(dirInfo, ancestor) {
+			// symlink loop; report the entry, but do not descend into it
+			return fn(path, info, nil)
+		}
+	}
+
+	names, readErr := readDirNames(path)
+	err := fn(path, info, readErr)
+
+	// If readErr != nil, walk can't walk into this directory. err != nil
+	// indicates that fn wants walk to skip this directory or stop walking
+	// the tree.
+	if readErr != nil || err != nil {
+		return err
+	}
+
+	ancestors = append(ancestors, dirInfo)
+
+	for _, name := range names {
+		filename := filepath.Join(path, name)
+
+		entryInfo, err := statEntry(filename, followSymlinks)
+		if err != nil {
+			if err := fn(filename, entryInfo, err); err != nil && !errors.Is(err, filepath.SkipDir) {
+				return err
+			}
+			continue
+		}
+
+		err = walkEntry(filename, entryInfo, followSymlinks, ancestors, fn)
+		if err != nil {
+			if !entryInfo.IsDir() || !errors.Is(err, filepath.SkipDir) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// statEntry returns the details of the specified path. If followSymlinks is
+// true and the path is a symlink whose target can be resolved, the details
+// of the target are returned.
+func statEntry(path string, followSymlinks bool) (os.FileInfo, error) {
+
+	info, err := os.Lstat(path)
+	if err != nil || !followSymlinks || info.Mode()&os.ModeSymlink == 0 {
+		return info, err
+	}
+
+	target, err := os.Stat(path)
+	if err != nil {
+		// dangling symlink or symlink which could not be resolved
+		return info, nil
+	}
+
+	return followedSymlink{FileInfo: target}, nil
+}
+
+// readDirNames reads the directory named by dirname and returns a sorted
+// list of directory entry names.
+func readDirNames(dirname string) ([]string, error) {
+
+	fh, err := os.Open(filepath.Clean(dirname))
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := fh.Readdirnames(-1)
+	_ = fh.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// symlinkDetails returns whether the given path is a symlink, the target of
+// the symlink and whether the target does not exist.
+func symlinkDetails(path string, info os.FileInfo) (bool, string, bool) {
+
+	_, followed := info.(followedSymlink)
+	if !followed && info.Mode()&os.ModeSymlink == 0 {
+		return false, "", false
+	}
+
+	// an unreadable link target is left empty
+	target, _ := os.Readlink(path)
+
+	if followed {
+		return true, target, false
+	}
+
+	_, statErr := os.Stat(path)
+	dangling := statErr != nil && os.IsNotExist(statErr)
+
+	return true, target, dangling
+}
@@ -0,0 +1,142 @@
+//go:build !windows
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// buildSymlinkTree creates the following tree within a temporary directory:
+//
+//	root/a
+//	root/broken -> missing
+//	root/data -> ../real
+//	real/b
+//	real/loop -> ../root
+func buildSymlinkTree(t *testing.T) string {
+	t.Helper()
+
+	base := t.TempDir()
+
+	for _, dir := range []string{"root", "real"} {
+		if err := os.Mkdir(filepath.Join(base, dir), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, file := range []string{"root/a", "real/b"} {
+		if err := os.WriteFile(filepath.Join(base, file), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	links := map[string]string{
+		"root/broken": "missing",
+		"root/data":   "../real",
+		"real/loop":   "../root",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(base, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return filepath.Join(base, "root")
+}
+
+func walkedPaths(t *testing.T, root string, followSymlinks bool) []string {
+	t.Helper()
+
+	var walked []string
+	err := walk(root, followSymlinks, func(path string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil {
+			return relErr
+		}
+		walked = append(walked, filepath.ToSlash(rel))
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error walking %s: %v", root, err)
+	}
+
+	return walked
+}
+
+func TestWalkNoFollow(t *testing.T) {
+	t.Parallel()
+
+	root := buildSymlinkTree(t)
+
+	got := walkedPaths(t, root, false)
+	want := []string{".", "a", "broken", "data"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("walked %v; want %v", got, want)
+	}
+}
+
+func TestWalkFollowDetectsLoops(t *testing.T) {
+	t.Parallel()
+
+	root := buildSymlinkTree(t)
+
+	// the loop entry is reported, but not descended into
+	got := walkedPaths(t, root, true)
+	want := []string{".", "a", "broken", "data", "data/b", "data/loop"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("walked %v; want %v", got, want)
+	}
+}
+
+func TestSymlinkDetails(t *testing.T) {
+	t.Parallel()
+
+	root := buildSymlinkTree(t)
+
+	for _, tc := range []struct {
+		name     string
+		follow   bool
+		symlink  bool
+		target   string
+		dangling bool
+	}{
+		{name: "a", follow: false},
+		{name: "broken", follow: false, symlink: true, target: "missing", dangling: true},
+		{name: "broken", follow: true, symlink: true, target: "missing", dangling: true},
+		{name: "data", follow: false, symlink: true, target: "../real"},
+		{name: "data", follow: true, symlink: true, target: "../real"},
+	} {
+		path := filepath.Join(root, tc.name)
+
+		info, err := statEntry(path, tc.follow)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", tc.name, err)
+		}
+
+		symlink, target, dangling := symlinkDetails(path, info)
+		if symlink != tc.symlink || target != tc.target || dangling != tc.dangling {
+			t.Errorf(
+				"symlinkDetails(%s, follow=%v) = (%v, %q, %v); want (%v, %q, %v)",
+				tc.name, tc.follow, symlink, target, dangling,
+				tc.symlink, tc.target, tc.dangling,
+			)
+		}
+	}
+}
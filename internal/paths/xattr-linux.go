@@ -0,0 +1,104 @@
+//go:build linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// Xattrs returns the extended attributes of the specified path. Symlinks
+// are not followed. Extended attributes which are not visible to the
+// current user (e.g., trusted.*) are not returned. An empty set is returned
+// if the filesystem hosting the path does not support extended attributes.
+func Xattrs(path string) (map[string][]byte, error) {
+
+	names, err := xattrNames(path)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make(map[string][]byte, len(names))
+	for _, name := range names {
+		value, err := xattrValue(path, name)
+		switch {
+		case errors.Is(err, unix.ENODATA):
+			// removed since listed
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to read extended attribute %s of %s: %w", name, path, err)
+		}
+
+		attrs[name] = value
+	}
+
+	return attrs, nil
+}
+
+// xattrNames returns the names of the extended attributes of the specified
+// path.
+func xattrNames(path string) ([]string, error) {
+	for {
+		size, err := unix.Llistxattr(path, nil)
+		switch {
+		case errors.Is(err, unix.ENOTSUP):
+			return nil, nil
+		case err != nil:
+			return nil, fmt.Errorf("failed to list extended attributes of %s: %w", path, err)
+		case size == 0:
+			return nil, nil
+		}
+
+		buf := make([]byte, size)
+		size, err = unix.Llistxattr(path, buf)
+		switch {
+		case errors.Is(err, unix.ERANGE):
+			// list grew since the size was requested
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to list extended attributes of %s: %w", path, err)
+		}
+
+		var names []string
+		for _, name := range bytes.Split(buf[:size], []byte{0}) {
+			if len(name) > 0 {
+				names = append(names, string(name))
+			}
+		}
+
+		return names, nil
+	}
+}
+
+// xattrValue returns the value of the named extended attribute of the
+// specified path.
+func xattrValue(path string, name string) ([]byte, error) {
+	for {
+		size, err := unix.Lgetxattr(path, name, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		buf := make([]byte, size)
+		size, err = unix.Lgetxattr(path, name, buf)
+		switch {
+		case errors.Is(err, unix.ERANGE):
+			// value grew since the size was requested
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		return buf[:size], nil
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Xattrs is a placeholder for operating systems where extended attributes
+// are not currently supported.
+func Xattrs(_ string) (map[string][]byte, error) {
+	return nil, fmt.Errorf("%w: %s", ErrXattrUnsupported, runtime.GOOS)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/atc0005/check-path/internal/textutils"
+)
+
+// ErrXattrUnsupported indicates that extended attributes are not supported
+// on the current operating system.
+var ErrXattrUnsupported = errors.New("extended attributes not supported on this operating system")
+
+// xattrRule is an extended attribute name pattern and optional value.
+type xattrRule struct {
+	spec     string
+	name     *regexp.Regexp
+	value    string
+	hasValue bool
+}
+
+// matches indicates whether any of the given extended attributes match the
+// rule.
+func (r xattrRule) matches(attrs map[string][]byte) bool {
+	for name, value := range attrs {
+		if !r.name.MatchString(name) {
+			continue
+		}
+
+		if !r.hasValue || string(value) == r.value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// XattrMatcher evaluates the extended attributes of an entry against lists
+// of required and forbidden extended attributes.
+type XattrMatcher struct {
+	required  []xattrRule
+	forbidden []xattrRule
+}
+
+// NewXattrMatcher compiles the given required and forbidden extended
+// attribute specifications into an XattrMatcher. Each specification is an
+// extended attribute name shell glob pattern (e.g., user.*) optionally
+// followed by =value to also match the value of the extended attribute.
+func NewXattrMatcher(required []string, forbidden []string) (XattrMatcher, error) {
+
+	compile := func(specs []string) ([]xattrRule, error) {
+		rules := make([]xattrRule, 0, len(specs))
+		for _, spec := range specs {
+			namePattern, value, hasValue := strings.Cut(spec, "=")
+
+			re, err := textutils.GlobToRegexp(namePattern)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile extended attribute pattern %q: %w", spec, err)
+			}
+
+			rules = append(rules, xattrRule{
+				spec:     spec,
+				name:     re,
+				value:    value,
+				hasValue: hasValue,
+			})
+		}
+
+		return rules, nil
+	}
+
+	requiredRules, err := compile(required)
+	if err != nil {
+		return XattrMatcher{}, err
+	}
+
+	forbiddenRules, err := compile(forbidden)
+	if err != nil {
+		return XattrMatcher{}, err
+	}
+
+	return XattrMatcher{
+		required:  requiredRules,
+		forbidden: forbiddenRules,
+	}, nil
+}
+
+// Evaluate returns the required extended attribute specifications not
+// matched by the given extended attributes and the forbidden extended
+// attribute specifications which are matched.
+func (xm XattrMatcher) Evaluate(attrs map[string][]byte) ([]string, []string) {
+	var missing []string
+	var forbidden []string
+
+	for _, rule := range xm.required {
+		if !rule.matches(attrs) {
+			missing = append(missing, rule.spec)
+		}
+	}
+
+	for _, rule := range xm.forbidden {
+		if rule.matches(attrs) {
+			forbidden = append(forbidden, rule.spec)
+		}
+	}
+
+	return missing, forbidden
+}
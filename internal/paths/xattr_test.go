@@ -0,0 +1,78 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package paths
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestXattrMatcherEvaluate(t *testing.T) {
+	t.Parallel()
+
+	matcher, err := NewXattrMatcher(
+		[]string{"user.tag=prod", "user.owner"},
+		[]string{"security.capability", "system.posix_acl_*"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		attrs         map[string][]byte
+		wantMissing   []string
+		wantForbidden []string
+	}{
+		{
+			name: "all required present",
+			attrs: map[string][]byte{
+				"user.tag":   []byte("prod"),
+				"user.owner": []byte("analytics"),
+			},
+		},
+		{
+			name: "required value mismatch",
+			attrs: map[string][]byte{
+				"user.tag":   []byte("dev"),
+				"user.owner": nil,
+			},
+			wantMissing: []string{"user.tag=prod"},
+		},
+		{
+			name:        "no attributes",
+			attrs:       map[string][]byte{},
+			wantMissing: []string{"user.tag=prod", "user.owner"},
+		},
+		{
+			name: "forbidden present",
+			attrs: map[string][]byte{
+				"user.tag":                []byte("prod"),
+				"user.owner":              nil,
+				"security.capability":     {0x01},
+				"system.posix_acl_access": {0x02},
+			},
+			wantForbidden: []string{"security.capability", "system.posix_acl_*"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			missing, forbidden := matcher.Evaluate(tt.attrs)
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("missing = %v; want %v", missing, tt.wantMissing)
+			}
+			if !reflect.DeepEqual(forbidden, tt.wantForbidden) {
+				t.Errorf("forbidden = %v; want %v", forbidden, tt.wantForbidden)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package textutils
+
+// IsDigits indicates whether the given string is non-empty and consists only
+// of ASCII digits. Signs, spaces and other characters accepted by
+// strconv.Atoi are not permitted.
+func IsDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
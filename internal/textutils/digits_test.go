@@ -0,0 +1,34 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package textutils
+
+import "testing"
+
+func TestIsDigits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		"1001":       true,
+		"0":          true,
+		"0001":       true,
+		"4294967296": true,
+		"":           false,
+		"+1001":      false,
+		"-1":         false,
+		" 1001":      false,
+		"1e3":        false,
+		"deploy":     false,
+		"١٢":         false,
+	}
+
+	for input, want := range tests {
+		if got := IsDigits(input); got != want {
+			t.Errorf("IsDigits(%q) = %v; want %v", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package textutils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GlobToRegexp converts a shell glob pattern into an anchored regular
+// expression. In addition to the `*`, `?` and `[...]` wildcards supported by
+// filepath.Match, a `**` wildcard matches any number of characters
+// (including path separators). A pattern component of `**/` also matches
+// zero directories, so `**/*.log` matches `app.log` and `logs/app.log`.
+//
+// Patterns are expected to use forward slashes as path separators.
+func GlobToRegexp(pattern string) (*regexp.Regexp, error) {
+
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+					continue
+				}
+				sb.WriteString(".*")
+				continue
+			}
+			sb.WriteString("[^/]*")
+
+		case '?':
+			sb.WriteString("[^/]")
+
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob pattern %q: unterminated character class", pattern)
+			}
+
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			sb.WriteString("[")
+			sb.WriteString(strings.ReplaceAll(class, `\`, `\\`))
+			sb.WriteString("]")
+			i += end + 1
+
+		case '\\':
+			// escape the next character, if any
+			if i+1 < len(pattern) {
+				i++
+				sb.WriteString(regexp.QuoteMeta(string(pattern[i])))
+				continue
+			}
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+
+	return re, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package textutils
+
+import "testing"
+
+func TestGlobToRegexp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		input   string
+		want    bool
+	}{
+		{pattern: "*.log", input: "app.log", want: true},
+		{pattern: "*.log", input: "logs/app.log", want: false},
+		{pattern: "*.log", input: "app.log.1", want: false},
+		{pattern: "app-?.log", input: "app-1.log", want: true},
+		{pattern: "app-?.log", input: "app-10.log", want: false},
+		{pattern: "app-[0-9].log", input: "app-7.log", want: true},
+		{pattern: "app-[!0-9].log", input: "app-7.log", want: false},
+		{pattern: "app-[!0-9].log", input: "app-x.log", want: true},
+		{pattern: "**/*.log", input: "app.log", want: true},
+		{pattern: "**/*.log", input: "a/b/c/app.log", want: true},
+		{pattern: "logs/**", input: "logs/a/b.tmp", want: true},
+		{pattern: "logs/**", input: "other/logs/b.tmp", want: false},
+		{pattern: "a/**/z", input: "a/z", want: true},
+		{pattern: "a/**/z", input: "a/b/c/z", want: true},
+		{pattern: "file.txt", input: "fileXtxt", want: false},
+		{pattern: `\*.txt`, input: "*.txt", want: true},
+		{pattern: `\*.txt`, input: "a.txt", want: false},
+	}
+
+	for _, tt := range tests {
+		re, err := GlobToRegexp(tt.pattern)
+		if err != nil {
+			t.Fatalf("unexpected error converting %q: %v", tt.pattern, err)
+		}
+
+		if got := re.MatchString(tt.input); got != tt.want {
+			t.Errorf("pattern %q matching %q = %v; want %v", tt.pattern, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestGlobToRegexpInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := GlobToRegexp("app-[0-9.log"); err == nil {
+		t.Error("expected error for unterminated character class")
+	}
+}
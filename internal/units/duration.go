@@ -0,0 +1,150 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Day and Week are the durations represented by the "d" and "w" suffixes
+// accepted by ParseDuration. These are fixed 24 hour and 7 day periods and
+// do not account for daylight saving time changes.
+const (
+	Day  time.Duration = 24 * time.Hour
+	Week time.Duration = 7 * Day
+)
+
+// ErrInvalidDuration is returned by ParseDuration when given a value which
+// cannot be parsed as a duration.
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// durationUnits maps the supported duration suffixes to their value. This
+// extends the units supported by time.ParseDuration with days and weeks.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  Day,
+	"w":  Week,
+}
+
+// ParseDuration parses a duration string such as "30m", "6h", "2d", "1w" or
+// "1d12h". The units supported by time.ParseDuration are accepted along with
+// "d" for days and "w" for weeks. A value without any unit suffix (e.g.,
+// "7") is interpreted using the given default unit, which allows existing
+// whole number values to retain their original meaning.
+func ParseDuration(s string, defaultUnit time.Duration) (time.Duration, error) {
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty string", ErrInvalidDuration)
+	}
+
+	// whole number without a unit suffix
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if defaultUnit > 0 &&
+			(n > math.MaxInt64/int64(defaultUnit) || n < math.MinInt64/int64(defaultUnit)) {
+			return 0, fmt.Errorf("%w: %q out of range", ErrInvalidDuration, s)
+		}
+		return time.Duration(n) * defaultUnit, nil
+	}
+
+	var total time.Duration
+	remaining := s
+
+	for remaining != "" {
+
+		// leading number, possibly with a fractional component
+		i := 0
+		for i < len(remaining) && (remaining[i] == '.' || (remaining[i] >= '0' && remaining[i] <= '9')) {
+			i++
+		}
+
+		if i == 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+
+		value, err := strconv.ParseFloat(remaining[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		remaining = remaining[i:]
+
+		// unit suffix
+		j := 0
+		for j < len(remaining) && (remaining[j] < '0' || remaining[j] > '9') && remaining[j] != '.' {
+			j++
+		}
+
+		unit, ok := durationUnits[remaining[:j]]
+		if !ok {
+			return 0, fmt.Errorf(
+				"%w: unknown or missing unit %q in %q",
+				ErrInvalidDuration,
+				remaining[:j],
+				s,
+			)
+		}
+		remaining = remaining[j:]
+
+		// the float64 product is compared before conversion as converting
+		// an out of range value to an integer is implementation-specific
+		product := value * float64(unit)
+		if product >= math.MaxInt64 || time.Duration(product) > math.MaxInt64-total {
+			return 0, fmt.Errorf("%w: %q out of range", ErrInvalidDuration, s)
+		}
+
+		total += time.Duration(product)
+	}
+
+	return total, nil
+}
+
+// FormatDuration converts a duration to a human-readable string using the
+// same units accepted by ParseDuration (e.g., "2d", "1w3d", "6h30m"). Values
+// of a second or more are truncated to whole seconds.
+func FormatDuration(d time.Duration) string {
+
+	if d < 0 {
+		return "-" + FormatDuration(-d)
+	}
+
+	if d < time.Second {
+		return d.String()
+	}
+
+	d = d.Truncate(time.Second)
+
+	var sb strings.Builder
+	for _, u := range []struct {
+		suffix string
+		value  time.Duration
+	}{
+		{"w", Week},
+		{"d", Day},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	} {
+		if d >= u.value {
+			fmt.Fprintf(&sb, "%d%s", d/u.value, u.suffix)
+			d %= u.value
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/check-path
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package units
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input       string
+		defaultUnit time.Duration
+		want        time.Duration
+	}{
+		"bare number uses days default":  {input: "7", defaultUnit: Day, want: 7 * Day},
+		"bare number uses hours default": {input: "26", defaultUnit: time.Hour, want: 26 * time.Hour},
+		"minutes":                        {input: "30m", defaultUnit: Day, want: 30 * time.Minute},
+		"hours":                          {input: "6h", defaultUnit: Day, want: 6 * time.Hour},
+		"days":                           {input: "2d", defaultUnit: time.Hour, want: 2 * Day},
+		"weeks":                          {input: "1w", defaultUnit: time.Hour, want: Week},
+		"combined":                       {input: "1d12h", defaultUnit: time.Hour, want: 36 * time.Hour},
+		"fractional":                     {input: "1.5h", defaultUnit: Day, want: 90 * time.Minute},
+		"go style":                       {input: "1h30m15s", defaultUnit: Day, want: time.Hour + 30*time.Minute + 15*time.Second},
+		"surrounding whitespace":         {input: " 10m ", defaultUnit: Day, want: 10 * time.Minute},
+		"largest whole days":             {input: "106751", defaultUnit: Day, want: 106751 * Day},
+		"largest days with unit":         {input: "106751d", defaultUnit: time.Hour, want: 106751 * Day},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDuration(tt.input, tt.defaultUnit)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", tt.input, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"", "d", "10x", "1.2.3h", "h10", "10 m",
+		// out of range values
+		"106752", "-106752", "106752d", "15251w", "106751d24h",
+		"99999999999999999999w",
+	} {
+		if _, err := ParseDuration(input, Day); !errors.Is(err, ErrInvalidDuration) {
+			t.Errorf("ParseDuration(%q) error = %v; want %v", input, err, ErrInvalidDuration)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[time.Duration]string{
+		30 * time.Minute:              "30m",
+		6 * time.Hour:                 "6h",
+		2 * Day:                       "2d",
+		Week:                          "1w",
+		10 * Day:                      "1w3d",
+		36*time.Hour + 90*time.Second: "1d12h1m30s",
+		1500 * time.Millisecond:       "1s",
+		250 * time.Millisecond:        "250ms",
+	}
+
+	for input, want := range tests {
+		if got := FormatDuration(input); got != want {
+			t.Errorf("FormatDuration(%v) = %q; want %q", input, got, want)
+		}
+	}
+}